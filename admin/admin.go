@@ -0,0 +1,308 @@
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"github.com/ExchangeUnion/xud-simnet-bot/channels"
+	"github.com/ExchangeUnion/xud-simnet-bot/database"
+	"github.com/ExchangeUnion/xud-simnet-bot/faucet"
+	"github.com/ExchangeUnion/xud-simnet-bot/xudrpc"
+	"github.com/google/logger"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type Admin struct {
+	Host  string `long:"admin.host" description:"Host to which the HTTP server of the admin API will listen; defaults to 127.0.0.1 so that it is only reachable locally"`
+	Port  int    `long:"admin.port" description:"Port to which the HTTP server of the admin API will listen; 0 disables it"`
+	Token string `long:"admin.token" description:"Token that has to be sent as bearer token with every request to the admin API"`
+
+	manager *channels.ChannelManager
+	faucet  *faucet.Faucet
+
+	xud      *xudrpc.Xud
+	database *database.Database
+
+	// Returns the effective config with secrets redacted
+	getConfig func() (interface{}, error)
+}
+
+type peerRequest struct {
+	NodePubKey string `json:"nodePubKey"`
+	Currency   string `json:"currency"`
+}
+
+type peerResponse struct {
+	NodePubKey string                    `json:"nodePubKey"`
+	Alias      string                    `json:"alias,omitempty"`
+	Connected  bool                      `json:"connected"`
+	Channels   []database.ChannelRecord  `json:"channels"`
+	Failures   []database.ChannelFailure `json:"failures"`
+}
+
+type statusResponse struct {
+	ManagerPaused bool `json:"managerPaused"`
+	FaucetPaused  bool `json:"faucetPaused"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// Serves the admin API until the context is done
+func (admin *Admin) Start(
+	ctx context.Context,
+	manager *channels.ChannelManager,
+	faucet *faucet.Faucet,
+	xud *xudrpc.Xud,
+	database *database.Database,
+	getConfig func() (interface{}, error),
+) {
+	if admin.Port == 0 {
+		logger.Info("Admin API is disabled")
+		return
+	}
+
+	if admin.Token == "" {
+		logger.Warning("Admin API is disabled because no token is configured")
+		return
+	}
+
+	logger.Info("Starting admin API at: " + net.JoinHostPort(admin.Host, strconv.Itoa(admin.Port)))
+
+	admin.manager = manager
+	admin.faucet = faucet
+
+	admin.xud = xud
+	admin.database = database
+
+	admin.getConfig = getConfig
+
+	mux := http.NewServeMux()
+
+	admin.handle(mux, "/peers", http.MethodGet, admin.handleListPeers)
+	admin.handle(mux, "/peers/reset", http.MethodPost, admin.handleResetPeer)
+	admin.handle(mux, "/channels/open", http.MethodPost, admin.handleOpenChannel)
+
+	admin.handle(mux, "/status", http.MethodGet, admin.handleStatus)
+	admin.handle(mux, "/config", http.MethodGet, admin.handleConfig)
+
+	admin.handle(mux, "/manager/pause", http.MethodPost, admin.handlePause(manager.Pause))
+	admin.handle(mux, "/manager/resume", http.MethodPost, admin.handlePause(manager.Resume))
+	admin.handle(mux, "/faucet/pause", http.MethodPost, admin.handlePause(faucet.Pause))
+	admin.handle(mux, "/faucet/resume", http.MethodPost, admin.handlePause(faucet.Resume))
+
+	server := &http.Server{
+		Addr:    net.JoinHostPort(admin.Host, strconv.Itoa(admin.Port)),
+		Handler: mux,
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	err := server.ListenAndServe()
+
+	if err != nil && err != http.ErrServerClosed {
+		logger.Fatal("Could not start admin API: " + err.Error())
+	}
+
+	logger.Info("Stopped admin API")
+}
+
+// Registers a handler that is only called for authenticated requests with the expected method
+func (admin *Admin) handle(mux *http.ServeMux, path string, method string, handler http.HandlerFunc) {
+	mux.HandleFunc(path, func(writer http.ResponseWriter, request *http.Request) {
+		if !admin.isAuthenticated(request) {
+			writeResponse(writer, http.StatusUnauthorized, errorResponse{
+				Error: "invalid token",
+			})
+			return
+		}
+
+		if request.Method != method {
+			writer.Header().Set("Allow", method)
+			writeResponse(writer, http.StatusMethodNotAllowed, errorResponse{
+				Error: "method not allowed",
+			})
+			return
+		}
+
+		handler(writer, request)
+	})
+}
+
+func (admin *Admin) isAuthenticated(request *http.Request) bool {
+	expected := []byte("Bearer " + admin.Token)
+	return subtle.ConstantTimeCompare([]byte(request.Header.Get("Authorization")), expected) == 1
+}
+
+func (admin *Admin) handleListPeers(writer http.ResponseWriter, _ *http.Request) {
+	peers := map[string]*peerResponse{}
+
+	getPeer := func(nodePubKey string) *peerResponse {
+		peer, ok := peers[nodePubKey]
+
+		if !ok {
+			peer = &peerResponse{
+				NodePubKey: nodePubKey,
+				Channels:   []database.ChannelRecord{},
+				Failures:   []database.ChannelFailure{},
+			}
+			peers[nodePubKey] = peer
+		}
+
+		return peer
+	}
+
+	connected, err := admin.xud.ListPeers()
+
+	if err != nil {
+		writeResponse(writer, http.StatusInternalServerError, errorResponse{
+			Error: "could not get XUD peers: " + err.Error(),
+		})
+		return
+	}
+
+	for _, connectedPeer := range connected.Peers {
+		peer := getPeer(connectedPeer.NodePubKey)
+		peer.Alias = connectedPeer.Alias
+		peer.Connected = true
+	}
+
+	records, err := admin.database.GetAllChannelRecords()
+
+	if err != nil {
+		writeResponse(writer, http.StatusInternalServerError, errorResponse{
+			Error: "could not get opened channels: " + err.Error(),
+		})
+		return
+	}
+
+	for nodePubKey, nodeRecords := range records {
+		getPeer(nodePubKey).Channels = nodeRecords
+	}
+
+	failures, err := admin.database.GetAllChannelFailures()
+
+	if err != nil {
+		writeResponse(writer, http.StatusInternalServerError, errorResponse{
+			Error: "could not get failed channel openings: " + err.Error(),
+		})
+		return
+	}
+
+	for _, failure := range failures {
+		peer := getPeer(failure.NodePubKey)
+		peer.Failures = append(peer.Failures, failure)
+	}
+
+	writeResponse(writer, http.StatusOK, peers)
+}
+
+// Removes everything that was recorded about a node so that it is treated like a new one
+func (admin *Admin) handleResetPeer(writer http.ResponseWriter, request *http.Request) {
+	body, ok := parsePeerRequest(writer, request)
+
+	if !ok {
+		return
+	}
+
+	if err := admin.database.RemoveNode(body.NodePubKey); err != nil {
+		writeResponse(writer, http.StatusInternalServerError, errorResponse{
+			Error: "could not reset node: " + err.Error(),
+		})
+		return
+	}
+
+	logger.Info("Reset records of node " + body.NodePubKey + " via admin API")
+	writeResponse(writer, http.StatusOK, body)
+}
+
+func (admin *Admin) handleOpenChannel(writer http.ResponseWriter, request *http.Request) {
+	body, ok := parsePeerRequest(writer, request)
+
+	if !ok {
+		return
+	}
+
+	if body.Currency == "" {
+		writeResponse(writer, http.StatusBadRequest, errorResponse{
+			Error: "no currency was provided",
+		})
+		return
+	}
+
+	if err := admin.manager.ForceOpen(body.NodePubKey, body.Currency); err != nil {
+		writeResponse(writer, http.StatusBadRequest, errorResponse{
+			Error: "could not open channel: " + err.Error(),
+		})
+		return
+	}
+
+	writeResponse(writer, http.StatusAccepted, body)
+}
+
+func (admin *Admin) handleStatus(writer http.ResponseWriter, _ *http.Request) {
+	writeResponse(writer, http.StatusOK, admin.getStatus())
+}
+
+func (admin *Admin) handleConfig(writer http.ResponseWriter, _ *http.Request) {
+	config, err := admin.getConfig()
+
+	if err != nil {
+		writeResponse(writer, http.StatusInternalServerError, errorResponse{
+			Error: "could not get config: " + err.Error(),
+		})
+		return
+	}
+
+	writeResponse(writer, http.StatusOK, config)
+}
+
+func (admin *Admin) handlePause(action func()) http.HandlerFunc {
+	return func(writer http.ResponseWriter, _ *http.Request) {
+		action()
+		writeResponse(writer, http.StatusOK, admin.getStatus())
+	}
+}
+
+func (admin *Admin) getStatus() statusResponse {
+	return statusResponse{
+		ManagerPaused: admin.manager.IsPaused(),
+		FaucetPaused:  admin.faucet.IsPaused(),
+	}
+}
+
+func parsePeerRequest(writer http.ResponseWriter, request *http.Request) (body peerRequest, ok bool) {
+	if err := json.NewDecoder(request.Body).Decode(&body); err != nil {
+		writeResponse(writer, http.StatusBadRequest, errorResponse{
+			Error: "could not parse request: " + err.Error(),
+		})
+		return body, false
+	}
+
+	if body.NodePubKey == "" {
+		writeResponse(writer, http.StatusBadRequest, errorResponse{
+			Error: "no node public key was provided",
+		})
+		return body, false
+	}
+
+	return body, true
+}
+
+func writeResponse(writer http.ResponseWriter, status int, data interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+
+	_ = json.NewEncoder(writer).Encode(data)
+}
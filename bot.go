@@ -1,10 +1,13 @@
 package main
 
 import (
-	"github.com/ExchangeUnion/xud-simnet-bot/channels"
+	"context"
 	"github.com/ExchangeUnion/xud-simnet-bot/xudrpc"
 	"github.com/google/logger"
+	"os"
+	"os/signal"
 	"sync"
+	"syscall"
 )
 
 func main() {
@@ -12,51 +15,117 @@ func main() {
 	initLogger(cfg.LogFile)
 	logConfig(cfg)
 
+	err := validateOptions(cfg)
+	checkError("config", err, true)
+
+	ctx := handleSignals()
+
 	var wg sync.WaitGroup
-	wg.Add(2)
+	wg.Add(6)
 
-	info := initXud(cfg)
+	info := initXud(ctx, cfg)
 	initDiscord(cfg, info)
+	registerCommands(cfg)
 
-	logger.Info("Sanitizing currencies")
+	err = cfg.Ethereum.Init(ctx)
+	checkError("Ethereum", err, true)
 
-	var faucetCurrencies []channels.Channel
-	var channelCurrencies []channels.Channel
+	logger.Info("Validating currencies")
 
-	for i := range cfg.Channels {
-		entry := cfg.Channels[i]
+	currencies := cfg.getCurrencyConfig()
 
-		if entry.TokenAddress == "" && entry.Currency != "ETH" {
-			channelCurrencies = append(channelCurrencies, *entry)
-		} else {
-			faucetCurrencies = append(faucetCurrencies, *entry)
-		}
-	}
+	err = validateCurrencyConfig(currencies, cfg.Xud, cfg.Ethereum)
+	checkError("currencies", err, true)
+
+	err = currencies.resolveDecimals(cfg.Xud, cfg.Ethereum)
+	checkError("currencies", err, true)
+
+	currencies.apply(cfg)
+
+	err = cfg.Database.Init()
+	checkError("database", err, true)
+
+	go func() {
+		cfg.ChannelManager.Init(ctx, cfg.Xud, cfg.Discord, cfg.Database)
+		wg.Done()
+	}()
 
 	go func() {
-		cfg.Database.Init()
+		cfg.BalanceMonitor.Start(ctx, cfg.Ethereum, cfg.Xud, cfg.Discord)
+		wg.Done()
+	}()
 
-		cfg.ChannelManager.Init(channelCurrencies, cfg.Xud, cfg.Discord, cfg.Database)
+	go func() {
+		cfg.Faucet.Start(ctx, cfg.Ethereum, cfg.Discord, cfg.Database)
 		wg.Done()
 	}()
 
 	go func() {
-		err := cfg.Ethereum.Init()
+		watchConfig(ctx, cfg)
+		wg.Done()
+	}()
 
-		checkError("Ethereum", err, true)
+	go func() {
+		cfg.Metrics.Start(ctx)
+		wg.Done()
+	}()
 
-		cfg.Faucet.Start(faucetCurrencies, cfg.Ethereum, cfg.Discord)
+	go func() {
+		cfg.Admin.Start(ctx, cfg.ChannelManager, cfg.Faucet, cfg.Xud, cfg.Database, func() (interface{}, error) {
+			return cfg.redacted()
+		})
 		wg.Done()
 	}()
 
 	wg.Wait()
+	shutdown(cfg)
+}
+
+// Returns a context that is canceled once the process receives SIGINT or SIGTERM
+func handleSignals() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		received := <-signals
+		logger.Info("Received signal: " + received.String())
+
+		// Another signal kills the process if it does not shut down gracefully
+		signal.Stop(signals)
+		cancel()
+	}()
+
+	return ctx
+}
+
+func shutdown(cfg *config) {
 	logger.Info("Shutting down")
+
+	_ = cfg.Discord.SendMessage("Shutting down xud-simnet-bot")
+
+	if err := cfg.Xud.Close(); err != nil {
+		logger.Warning("Could not close XUD client: " + err.Error())
+	}
+
+	cfg.Ethereum.Close()
+
+	if err := cfg.Database.Close(); err != nil {
+		logger.Warning("Could not close database: " + err.Error())
+	}
+
+	if err := cfg.Discord.Close(); err != nil {
+		logger.Warning("Could not close Discord client: " + err.Error())
+	}
+
+	logger.Info("Shut down")
 }
 
-func initXud(cfg *config) *xudrpc.GetInfoResponse {
+func initXud(ctx context.Context, cfg *config) *xudrpc.GetInfoResponse {
 	logger.Info("Initializing XUD client")
 
-	err := cfg.Xud.Init()
+	err := cfg.Xud.Init(ctx)
 	checkError("XUD", err, true)
 
 	info, err := cfg.Xud.GetInfo()
@@ -1,51 +1,166 @@
 package channels
 
 import (
+	"context"
 	"github.com/ExchangeUnion/xud-simnet-bot/database"
 	"github.com/ExchangeUnion/xud-simnet-bot/discord"
+	"github.com/ExchangeUnion/xud-simnet-bot/metrics"
 	"github.com/ExchangeUnion/xud-simnet-bot/xudrpc"
 	"github.com/google/logger"
 	"math"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
 type ChannelManager struct {
-	Interval int `long:"manager.interval" default:"20" description:"Interval in seconds at which new channels should be opened"`
+	Interval int `long:"manager.interval" default:"20" description:"Interval in seconds at which all peers should be checked for channels that need to be opened"`
 
-	channels []Channel
+	Concurrency int `long:"manager.concurrency" default:"4" description:"Maximal number of channels that are opened at the same time"`
+	OpenTimeout int `long:"manager.opentimeout" default:"120" description:"Time in seconds after which an attempt to open a channel is canceled"`
+
+	PendingTimeout int `long:"manager.pendingtimeout" default:"86400" description:"Time in seconds after which an alert is sent for channels whose funding transaction was not confirmed"`
+	ReopenDelay    int `long:"manager.reopendelay" default:"3600" description:"Time in seconds after which channels that were closed are opened again"`
+
+	RetryDelay    int `long:"manager.retrydelay" default:"60" description:"Time in seconds after which a failed attempt to open a channel is retried; doubles with every failed attempt"`
+	MaxRetryDelay int `long:"manager.maxretrydelay" default:"21600" description:"Maximal time in seconds between attempts to open a channel"`
+	MaxAttempts   int `long:"manager.maxattempts" default:"10" description:"Number of failed attempts after which no more attempts to open a channel are made; 0 retries forever"`
+
+	InactivityWindow int `long:"manager.inactivitywindow" default:"1209600" description:"Time in seconds after which the channels to peers that were not connected anymore are closed; 0 disables"`
+	ReapInterval     int `long:"manager.reapinterval" default:"3600" description:"Interval in seconds at which channels to inactive peers should be closed"`
+
+	RequirePair         bool     `long:"manager.requirepair" description:"Only open channels in currencies for which the peer supports a trading pair"`
+	RequireLndPubKey    bool     `long:"manager.requirelndpubkey" description:"Only open channels in currencies for which the peer has an lnd public key"`
+	MinSecondsConnected int      `long:"manager.minconnected" description:"Time in seconds a peer has to be connected before channels are opened to it"`
+	MinXudVersion       string   `long:"manager.minxudversion" description:"Minimal XUD version a peer has to run to get channels"`
+	AllowList           []string `long:"manager.allow" description:"Node public key that should get channels; if set, all other nodes are ignored (can be specified multiple times)"`
+	DenyList            []string `long:"manager.deny" description:"Node public key that should never get channels (can be specified multiple times)"`
+
+	PreferredList        []string `long:"manager.preferred" description:"Node public key that should get channels with the preferred amounts (can be specified multiple times)"`
+	WalletShare          float64  `long:"manager.walletshare" description:"Maximal share of the wallet balance that a single channel may use; channels are scaled down accordingly; 0 disables"`
+	RespectTradingLimits bool     `long:"manager.respecttradinglimits" description:"Scale channels down to the maximal sell amount XUD reports for the currency"`
+	MaxPeerCapacity      float64  `long:"manager.maxpeercapacity" description:"Maximal capacity of all channels to a single peer in the reference unit of the channels; 0 disables"`
+
+	channels     []Channel
+	channelsLock sync.RWMutex
+
+	ctx context.Context
+
+	// Set of the peers that were connected when they were checked the last time
+	connectedPeers map[string]bool
+
+	openRequests chan openRequest
+	// Goroutines that have to finish before the clients the manager uses are closed
+	workers sync.WaitGroup
+
+	// Set of the peer and currency combinations that are queued or being opened
+	// The values are the capacities of the channels in the reference unit
+	inFlight     map[string]float64
+	inFlightLock sync.Mutex
+
+	// Map between peer and currency combinations and the last error with which closing their channel failed
+	closeFailures     map[string]string
+	closeFailuresLock sync.Mutex
+
+	// Keys of the reconciliation alerts that were sent already
+	alerts     map[string]bool
+	alertsLock sync.Mutex
+
+	// Set to 1 while no channels should be opened or closed automatically
+	paused int32
+	// Set to 1 while channels to inactive peers are being closed
+	reaping int32
 
 	xud      *xudrpc.Xud
 	discord  *discord.Discord
 	database *database.Database
 }
 
-// This struct has a dualistic nature:
-// 1. Lightning channel creations
-//    "Currency", "Amount" and "PushAmount" need to be set
-//
-// 2. Ethereum token faucet
-//    "Currency", "TokenAddress", "Amount" need to be set
-//
-//    If the "TokenAddress" is set or the "Currency" equals "ETH"
-//    no channels will be created for that currency but the faucet
-//    will send tokens on request
+// Lightning channel that is opened to every peer; Raiden channels are converted to this type too
 type Channel struct {
 	// Symbol of the currency
 	Currency string
-	// Address of the Ethereum token
-	TokenAddress string
-	// Capacity of the channel or amount of token that should be sent
+	// Capacity of the channel
 	Amount float64
-	// Amount that should be pushed to the other side in case of a channel creation
+	// Amount that should be pushed to the other side
 	PushAmount float64
+	// Balance of the wallet of the XUD node below which a warning is sent to Discord
+	MinBalance float64
+
+	// Capacity and push amount of channels to peers on the preferred list; the regular amounts are used if not set
+	PreferredAmount     float64
+	PreferredPushAmount float64
+	// Channels that would have to be scaled down below this capacity are not opened
+	MinAmount float64
+	// Value of one coin in the reference unit of the capacity cap per peer; defaults to 1
+	ReferenceRate float64
+
+	// Whether the channel is opened to the Raiden address of the peer
+	raiden bool
 }
 
 var decimals = math.Pow(10, 8)
 
-func (manager *ChannelManager) Init(channels []Channel, xud *xudrpc.Xud, discord *discord.Discord, database *database.Database) {
+// Opens the channels that were set until the context is done
+func (manager *ChannelManager) Init(ctx context.Context, xud *xudrpc.Xud, discord *discord.Discord, database *database.Database) {
 	logger.Info("Initializing channel manager")
 
+	manager.ctx = ctx
+	manager.connectedPeers = map[string]bool{}
+
+	manager.xud = xud
+	manager.discord = discord
+	manager.database = database
+
+	manager.registerCommands()
+	manager.startWorkers()
+
+	peerEvents := make(chan string, peerEventsBuffer)
+	manager.workers.Add(1)
+
+	go func() {
+		defer manager.workers.Done()
+		manager.subscribePeerEvents(peerEvents)
+	}()
+
+	// Polling all peers is kept as fallback for peers that are not detected by the subscription
+	ticker := time.NewTicker(time.Duration(manager.Interval) * time.Second)
+	defer ticker.Stop()
+
+	reapTicker := time.NewTicker(time.Duration(manager.ReapInterval) * time.Second)
+	defer reapTicker.Stop()
+
+	manager.openChannels()
+
+	for {
+		select {
+		case <-ctx.Done():
+			manager.workers.Wait()
+
+			logger.Info("Stopped channel manager")
+			return
+
+		case <-reapTicker.C:
+			if manager.InactivityWindow > 0 && !manager.IsPaused() {
+				manager.startReaping()
+			}
+
+		case nodePubKey := <-peerEvents:
+			if !manager.connectedPeers[nodePubKey] && !manager.IsPaused() {
+				manager.handleNewPeer(nodePubKey)
+			}
+
+		case <-ticker.C:
+			if !manager.IsPaused() {
+				manager.openChannels()
+			}
+		}
+	}
+}
+
+// Replaces the channels that are opened; channels that are queued already are opened with their previous config
+func (manager *ChannelManager) SetChannels(channels []Channel) {
 	var channelNames []string
 
 	for _, channel := range channels {
@@ -54,19 +169,18 @@ func (manager *ChannelManager) Init(channels []Channel, xud *xudrpc.Xud, discord
 
 	logger.Info("Channel manager currencies: " + strings.Join(channelNames, ", "))
 
-	manager.channels = channels
+	manager.channelsLock.Lock()
+	defer manager.channelsLock.Unlock()
 
-	manager.xud = xud
-	manager.discord = discord
-	manager.database = database
+	manager.channels = channels
+}
 
-	ticker := time.NewTicker(time.Duration(manager.Interval) * time.Second)
+// The returned slice must not be modified because it is shared with other callers
+func (manager *ChannelManager) getChannels() []Channel {
+	manager.channelsLock.RLock()
+	defer manager.channelsLock.RUnlock()
 
-	manager.openChannels()
-
-	for range ticker.C {
-		manager.openChannels()
-	}
+	return manager.channels
 }
 
 func (manager *ChannelManager) openChannels() {
@@ -80,58 +194,152 @@ func (manager *ChannelManager) openChannels() {
 		return
 	}
 
+	manager.reconcileChannels()
+
+	manager.connectedPeers = map[string]bool{}
+
+	for _, peer := range peers.Peers {
+		manager.connectedPeers[peer.NodePubKey] = true
+	}
+
+	manager.updateLastSeen(peers.Peers)
+
+	for _, peer := range peers.Peers {
+		manager.openPeerChannels(peer)
+	}
+}
+
+func (manager *ChannelManager) handleNewPeer(nodePubKey string) {
+	peers, err := manager.xud.ListPeers()
+
+	if err != nil {
+		logger.Warning("Could not get XUD peers: " + err.Error())
+		return
+	}
+
 	for _, peer := range peers.Peers {
-		channelsOpened := manager.database.GetChannelsOpened(peer.NodePubKey)
+		if peer.NodePubKey == nodePubKey {
+			logger.Info("Detected new peer: " + nodePubKey)
+
+			manager.connectedPeers[nodePubKey] = true
+			manager.updateLastSeen([]*xudrpc.Peer{peer})
+			manager.openPeerChannels(peer)
+			return
+		}
+	}
+}
+
+// Queues the channels that need to be opened to the peer for the workers
+func (manager *ChannelManager) openPeerChannels(peer *xudrpc.Peer) {
+	if manager.getPeerIneligibility(peer) != "" {
+		return
+	}
+
+	records, err := manager.database.GetChannelRecords(peer.NodePubKey)
+
+	if err != nil {
+		logger.Warning("Could not get opened channels of " + peer.NodePubKey + ": " + err.Error())
+		return
+	}
+
+	failures, err := manager.database.GetChannelFailures(peer.NodePubKey)
+
+	if err != nil {
+		logger.Warning("Could not get failed channel openings of " + peer.NodePubKey + ": " + err.Error())
+		return
+	}
+
+	state := &nodeState{xud: manager.xud}
 
-		if len(channelsOpened) == len(manager.channels) {
+	for _, channel := range manager.getChannels() {
+		if !manager.shouldOpenChannel(records, channel.Currency) || manager.getCurrencyIneligibility(peer, channel) != "" {
 			continue
 		}
 
-		nodeInfo := "**" + peer.Alias + "** (`" + peer.NodePubKey + "`)"
+		var failure *database.ChannelFailure
 
-		for _, channel := range manager.channels {
-			channelOpenedAlready := false
+		if previous, ok := failures[channel.Currency]; ok {
+			failure = &previous
+		}
 
-			for _, channelOpened := range channelsOpened {
-				if channelOpened == channel.Currency {
-					channelOpenedAlready = true
-					break
-				}
-			}
+		if !manager.shouldRetry(failure) {
+			continue
+		}
 
-			if channelOpenedAlready {
-				continue
-			}
+		if err := manager.queueOpenRequest(peer, channel, failure, records, state); err != nil && err != errAlreadyQueued {
+			logger.Info("Not opening " + channel.Currency + " channel to " + peer.NodePubKey + ": " + err.Error())
+		}
+	}
+}
 
-			message := "Opening " + channel.Currency + " channel to " + nodeInfo
+// The previous failure is nil if this is the first attempt to open the channel
+func (manager *ChannelManager) openChannel(peer *xudrpc.Peer, channel Channel, size channelSize, failure *database.ChannelFailure) {
+	nodeInfo := "**" + peer.Alias + "** (`" + peer.NodePubKey + "`)"
+	message := "Opening " + channel.Currency + " " + channel.getNetwork() + " channel with " + strconv.FormatFloat(size.amount, 'f', -1, 64) +
+		" " + channel.Currency + " capacity to " + nodeInfo
 
-			logger.Info(message)
-			_ = manager.discord.SendMessage(message)
+	logger.Info(message)
 
-			_, err := manager.xud.OpenChannel(&xudrpc.OpenChannelRequest{
-				Amount:         coinsToSatoshis(channel.Amount),
-				PushAmount:     coinsToSatoshis(channel.PushAmount),
-				Currency:       channel.Currency,
-				NodeIdentifier: peer.NodePubKey,
-			})
+	// Retries are only reported when they fail with a different error or succeed
+	if failure == nil {
+		_ = manager.discord.SendMessage(message)
+	}
 
-			if err == nil {
-				manager.database.AddChannelsOpened(peer.NodePubKey, channel.Currency)
+	_, err := manager.xud.OpenChannel(&xudrpc.OpenChannelRequest{
+		Amount:         coinsToSatoshis(size.amount),
+		PushAmount:     coinsToSatoshis(size.pushAmount),
+		Currency:       channel.Currency,
+		NodeIdentifier: peer.NodePubKey,
+	}, time.Duration(manager.OpenTimeout)*time.Second)
 
-				message := "Opened " + channel.Currency + " channel to " + nodeInfo
+	if err == nil {
+		metrics.ChannelsOpened.WithLabelValues(channel.Currency).Inc()
+		manager.clearFailure(peer, channel, failure)
 
-				logger.Info(message)
-				_ = manager.discord.SendMessage(message)
-			} else {
-				message = "Could not open " + channel.Currency + " channel to " + nodeInfo + ": " + err.Error()
+		// XUD returns only after Raiden channels were opened on chain
+		state := database.ChannelPending
 
-				logger.Warning(message)
-				_ = manager.discord.SendMessage(message)
-			}
+		if channel.raiden {
+			state = database.ChannelOpen
 		}
+
+		if err := manager.database.AddChannelsOpened(peer.NodePubKey, channel.Currency, size.amount, state); err != nil {
+			logger.Error("Could not save opened " + channel.Currency + " channel to database: " + err.Error())
+		}
+
+		message := "Opened " + channel.Currency + " " + channel.getNetwork() + " channel to " + nodeInfo
+
+		logger.Info(message)
+		_ = manager.discord.SendMessage(message)
+	} else {
+		// Calls to XUD fail once the context is done which should not be reported as failed channel openings
+		if manager.ctx.Err() != nil {
+			return
+		}
+
+		metrics.ChannelsFailed.WithLabelValues(channel.Currency).Inc()
+		manager.handleOpenFailure(peer, channel, failure, err)
 	}
 }
 
+func (manager *ChannelManager) shouldOpenChannel(records []database.ChannelRecord, currency string) bool {
+	for _, record := range records {
+		if record.Currency != currency {
+			continue
+		}
+
+		// Closed channels are opened again after a grace period
+		return record.GetState() == database.ChannelClosed &&
+			time.Since(record.UpdatedAt) >= time.Duration(manager.ReopenDelay)*time.Second
+	}
+
+	return true
+}
+
 func coinsToSatoshis(coins float64) int64 {
 	return int64(math.Round(coins * decimals))
 }
+
+func satoshisToCoins(satoshis uint64) float64 {
+	return float64(satoshis) / decimals
+}
@@ -0,0 +1,114 @@
+package channels
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func (manager *ChannelManager) registerCommands() {
+	manager.discord.AddCommand("channels", "<node pubkey>", "Shows which channels were opened to a XUD node", manager.handleChannelsCommand)
+	manager.discord.AddCommand("failures", "", "Shows channels that could not be opened and are retried or were given up", manager.handleFailuresCommand)
+}
+
+func (manager *ChannelManager) handleChannelsCommand(_ string, args []string) (string, error) {
+	if len(args) != 1 {
+		return "", errors.New("exactly one node public key has to be provided")
+	}
+
+	nodePubKey := args[0]
+
+	records, err := manager.database.GetChannelRecords(nodePubKey)
+
+	if err != nil {
+		return "", errors.New("could not get opened channels: " + err.Error())
+	}
+
+	var reply string
+
+	if len(records) == 0 {
+		reply = "No channels were opened to `" + nodePubKey + "` yet"
+	} else {
+		var channels []string
+
+		for _, record := range records {
+			channels = append(channels, record.Currency+" ("+string(record.GetState())+")")
+		}
+
+		reply = "Opened channels to `" + nodePubKey + "`: " + strings.Join(channels, ", ")
+	}
+
+	return reply + manager.describeIneligibility(nodePubKey), nil
+}
+
+// Explains why channels are not opened to a connected peer
+func (manager *ChannelManager) describeIneligibility(nodePubKey string) string {
+	peers, err := manager.xud.ListPeers()
+
+	if err != nil {
+		return ""
+	}
+
+	for _, peer := range peers.Peers {
+		if peer.NodePubKey != nodePubKey {
+			continue
+		}
+
+		if reason := manager.getPeerIneligibility(peer); reason != "" {
+			return "\nNo channels are opened because the " + reason
+		}
+
+		var reasons []string
+
+		for _, channel := range manager.getChannels() {
+			if reason := manager.getCurrencyIneligibility(peer, channel); reason != "" {
+				reasons = append(reasons, channel.Currency+": "+reason)
+			}
+		}
+
+		if len(reasons) != 0 {
+			return "\nSkipped currencies: " + strings.Join(reasons, "; ")
+		}
+	}
+
+	return ""
+}
+
+func (manager *ChannelManager) handleFailuresCommand(_ string, _ []string) (string, error) {
+	failures, err := manager.database.GetAllChannelFailures()
+
+	if err != nil {
+		return "", errors.New("could not get failed channel openings: " + err.Error())
+	}
+
+	if len(failures) == 0 {
+		return "No channel openings failed", nil
+	}
+
+	sort.Slice(failures, func(i, j int) bool {
+		return failures[i].LastAttempt.Before(failures[j].LastAttempt)
+	})
+
+	reply := "Failed channel openings:"
+
+	for _, failure := range failures {
+		reply += "\n- " + failure.Currency + " to `" + failure.NodePubKey + "` after " + strconv.Itoa(failure.Attempts) +
+			" attempts (" + failure.LastError + "): "
+
+		if failure.GivenUp {
+			reply += "**given up**"
+		} else {
+			nextAttempt := time.Until(failure.LastAttempt.Add(manager.getRetryDelay(failure.Attempts))).Round(time.Second)
+
+			if nextAttempt < 0 {
+				nextAttempt = 0
+			}
+
+			reply += "retrying in " + nextAttempt.String()
+		}
+	}
+
+	return reply, nil
+}
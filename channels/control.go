@@ -0,0 +1,65 @@
+package channels
+
+import (
+	"errors"
+	"github.com/google/logger"
+	"sync/atomic"
+)
+
+// Stops opening and closing channels automatically; explicitly requested channels are still opened
+func (manager *ChannelManager) Pause() {
+	atomic.StoreInt32(&manager.paused, 1)
+	logger.Info("Paused channel manager")
+}
+
+func (manager *ChannelManager) Resume() {
+	atomic.StoreInt32(&manager.paused, 0)
+	logger.Info("Resumed channel manager")
+}
+
+func (manager *ChannelManager) IsPaused() bool {
+	return atomic.LoadInt32(&manager.paused) == 1
+}
+
+// Queues a channel to a connected peer regardless of whether it was opened already, failed or the peer is eligible
+func (manager *ChannelManager) ForceOpen(nodePubKey string, currency string) error {
+	var channel *Channel
+
+	for _, configured := range manager.getChannels() {
+		if configured.Currency == currency {
+			channel = &configured
+			break
+		}
+	}
+
+	if channel == nil {
+		return errors.New("no channels are opened in currency: " + currency)
+	}
+
+	peers, err := manager.xud.ListPeers()
+
+	if err != nil {
+		return errors.New("could not get XUD peers: " + err.Error())
+	}
+
+	for _, peer := range peers.Peers {
+		if peer.NodePubKey != nodePubKey {
+			continue
+		}
+
+		if err := manager.database.RemoveChannelFailure(nodePubKey, currency); err != nil {
+			return errors.New("could not reset failed channel openings: " + err.Error())
+		}
+
+		records, err := manager.database.GetChannelRecords(nodePubKey)
+
+		if err != nil {
+			return errors.New("could not get opened channels: " + err.Error())
+		}
+
+		logger.Info("Forcing " + currency + " channel to " + nodePubKey)
+		return manager.queueOpenRequest(peer, *channel, nil, records, &nodeState{xud: manager.xud})
+	}
+
+	return errors.New("node is not connected: " + nodePubKey)
+}
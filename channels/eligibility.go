@@ -0,0 +1,113 @@
+package channels
+
+import (
+	"github.com/ExchangeUnion/xud-simnet-bot/xudrpc"
+	"strconv"
+	"strings"
+)
+
+// Returns the reason why no channels should be opened to the peer or an empty string if the peer is eligible
+func (manager *ChannelManager) getPeerIneligibility(peer *xudrpc.Peer) string {
+	for _, denied := range manager.DenyList {
+		if denied == peer.NodePubKey {
+			return "node is on the deny list"
+		}
+	}
+
+	if len(manager.AllowList) != 0 && !manager.isAllowListed(peer.NodePubKey) {
+		return "node is not on the allow list"
+	}
+
+	if peer.SecondsConnected < uint32(manager.MinSecondsConnected) {
+		return "node is connected for only " + strconv.FormatUint(uint64(peer.SecondsConnected), 10) + " seconds"
+	}
+
+	if manager.MinXudVersion != "" && compareVersions(peer.XudVersion, manager.MinXudVersion) < 0 {
+		return "XUD version " + peer.XudVersion + " is older than " + manager.MinXudVersion
+	}
+
+	return ""
+}
+
+// Returns the reason why the channel should not be opened to the peer or an empty string if it is eligible
+func (manager *ChannelManager) getCurrencyIneligibility(peer *xudrpc.Peer, channel Channel) string {
+	currency := channel.Currency
+
+	if manager.RequirePair && !supportsCurrency(peer.Pairs, currency) {
+		return "node does not support a pair with " + currency
+	}
+
+	// Raiden channels cannot be opened without the address of the peer
+	if channel.raiden {
+		if peer.RaidenAddress == "" {
+			return "node has no Raiden address"
+		}
+	} else if manager.RequireLndPubKey && peer.LndPubKeys[currency] == "" {
+		return "node has no lnd public key for " + currency
+	}
+
+	return ""
+}
+
+func (manager *ChannelManager) isAllowListed(nodePubKey string) bool {
+	for _, allowed := range manager.AllowList {
+		if allowed == nodePubKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Pairs are formatted like "LTC/BTC"
+func supportsCurrency(pairs []string, currency string) bool {
+	for _, pair := range pairs {
+		for _, pairCurrency := range strings.Split(pair, "/") {
+			if pairCurrency == currency {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Compares the numeric parts of versions like "1.0.0-beta.2" and ignores everything after the first "-"
+// Returns a negative number if a is older than b, zero if they are equal and a positive number otherwise
+func compareVersions(a string, b string) int {
+	aParts := parseVersion(a)
+	bParts := parseVersion(b)
+
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aPart, bPart int
+
+		if i < len(aParts) {
+			aPart = aParts[i]
+		}
+
+		if i < len(bParts) {
+			bPart = bParts[i]
+		}
+
+		if aPart != bPart {
+			return aPart - bPart
+		}
+	}
+
+	return 0
+}
+
+func parseVersion(version string) []int {
+	version = strings.TrimPrefix(version, "v")
+	version = strings.SplitN(version, "-", 2)[0]
+
+	var parts []int
+
+	for _, part := range strings.Split(version, ".") {
+		// Parts that are not numeric are treated as zero
+		number, _ := strconv.Atoi(part)
+		parts = append(parts, number)
+	}
+
+	return parts
+}
@@ -0,0 +1,43 @@
+package channels
+
+import "testing"
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a string
+		b string
+		// Sign of the result
+		expected int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"v1.0.0", "1.0.0", 0},
+		{"1.0.0-rc.1", "1.0.0", 0},
+		{"1.0", "1.0.0", 0},
+		{"1.0.1", "1.0.0", 1},
+		{"1.0.0", "1.0.1", -1},
+		{"1.10.0", "1.9.0", 1},
+		{"2.0.0", "1.99.99", 1},
+		{"1.0.0.1", "1.0.0", 1},
+		{"", "0.0.1", -1},
+		{"1.x.0", "1.0.0", 0},
+	}
+
+	for _, test := range tests {
+		result := compareVersions(test.a, test.b)
+
+		if sign(result) != test.expected {
+			t.Errorf("compareVersions(%q, %q) = %d; expected sign %d", test.a, test.b, result, test.expected)
+		}
+	}
+}
+
+func sign(value int) int {
+	switch {
+	case value > 0:
+		return 1
+	case value < 0:
+		return -1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,58 @@
+package channels
+
+import (
+	"github.com/ExchangeUnion/xud-simnet-bot/xudrpc"
+	"github.com/google/logger"
+	"time"
+)
+
+const peerEventsBuffer = 128
+
+const resubscribeDelay = 10 * time.Second
+
+// XUD has no stream for peer connections but peers send their orders right after they connected
+// That is why the order stream is used to detect new peers within seconds
+func (manager *ChannelManager) subscribePeerEvents(peerEvents chan<- string) {
+	for {
+		stream, err := manager.xud.SubscribeOrders()
+
+		if err == nil {
+			err = manager.receivePeerEvents(stream, peerEvents)
+		}
+
+		if manager.ctx.Err() != nil {
+			return
+		}
+
+		logger.Warning("Order subscription failed: " + err.Error() + "; resubscribing in " + resubscribeDelay.String())
+
+		select {
+		case <-manager.ctx.Done():
+			return
+
+		case <-time.After(resubscribeDelay):
+		}
+	}
+}
+
+func (manager *ChannelManager) receivePeerEvents(stream xudrpc.Xud_SubscribeOrdersClient, peerEvents chan<- string) error {
+	for {
+		update, err := stream.Recv()
+
+		if err != nil {
+			return err
+		}
+
+		nodePubKey := update.GetOrder().GetPeerPubKey()
+
+		if nodePubKey == "" {
+			continue
+		}
+
+		select {
+		case peerEvents <- nodePubKey:
+		case <-manager.ctx.Done():
+			return manager.ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package channels
+
+// Raiden channel in an ERC20 token that is opened to the Raiden address of every peer
+type RaidenChannel struct {
+	// Symbol of the currency
+	Currency string
+	// Capacity of the channel; XUD cannot push funds to the other side of Raiden channels
+	Amount float64
+	// Balance of the wallet of the XUD node below which a warning is sent to Discord
+	MinBalance float64
+
+	// Capacity of channels to peers on the preferred list; the regular amount is used if not set
+	PreferredAmount float64
+	// Channels that would have to be scaled down below this capacity are not opened
+	MinAmount float64
+	// Value of one coin in the reference unit of the capacity cap per peer; defaults to 1
+	ReferenceRate float64
+}
+
+// Converts the config into a channel that is opened by the manager
+func (channel RaidenChannel) ToChannel() Channel {
+	return Channel{
+		Currency:        channel.Currency,
+		Amount:          channel.Amount,
+		MinBalance:      channel.MinBalance,
+		PreferredAmount: channel.PreferredAmount,
+		MinAmount:       channel.MinAmount,
+		ReferenceRate:   channel.ReferenceRate,
+		raiden:          true,
+	}
+}
+
+// Returns the name of the payment channel network of the channel
+func (channel *Channel) getNetwork() string {
+	if channel.raiden {
+		return "Raiden"
+	}
+
+	return "lightning"
+}
@@ -0,0 +1,195 @@
+package channels
+
+import (
+	"github.com/ExchangeUnion/xud-simnet-bot/database"
+	"github.com/ExchangeUnion/xud-simnet-bot/xudrpc"
+	"github.com/google/logger"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const closeTimeout = 2 * time.Minute
+
+func (manager *ChannelManager) updateLastSeen(peers []*xudrpc.Peer) {
+	var nodePubKeys []string
+
+	for _, peer := range peers {
+		nodePubKeys = append(nodePubKeys, peer.NodePubKey)
+	}
+
+	if err := manager.database.SetPeersLastSeen(nodePubKeys, time.Now()); err != nil {
+		logger.Warning("Could not save when peers were seen: " + err.Error())
+	}
+}
+
+// Reaps the channels in the background because closing them can block for minutes; runs do not overlap
+func (manager *ChannelManager) startReaping() {
+	if !atomic.CompareAndSwapInt32(&manager.reaping, 0, 1) {
+		return
+	}
+
+	// The set is copied because it is replaced by the loop of the manager
+	connectedPeers := make(map[string]bool, len(manager.connectedPeers))
+
+	for nodePubKey := range manager.connectedPeers {
+		connectedPeers[nodePubKey] = true
+	}
+
+	manager.workers.Add(1)
+
+	go func() {
+		defer manager.workers.Done()
+		defer atomic.StoreInt32(&manager.reaping, 0)
+
+		manager.reapChannels(connectedPeers)
+	}()
+}
+
+// Closes the channels to nodes that were not connected for longer than the inactivity window to recover the capital
+// and removes their records so that they get new channels if they come back
+func (manager *ChannelManager) reapChannels(connectedPeers map[string]bool) {
+	allRecords, err := manager.database.GetAllChannelRecords()
+
+	if err != nil {
+		logger.Warning("Could not get recorded channels: " + err.Error())
+		return
+	}
+
+	lastSeen, err := manager.database.GetAllPeersLastSeen()
+
+	if err != nil {
+		logger.Warning("Could not get when peers were seen: " + err.Error())
+		return
+	}
+
+	inactivityWindow := time.Duration(manager.InactivityWindow) * time.Second
+
+	var unseen []string
+
+	for nodePubKey, records := range allRecords {
+		if manager.ctx.Err() != nil {
+			return
+		}
+
+		// Nodes that were never seen since the last seen times are tracked get the full inactivity window
+		seenAt, ok := lastSeen[nodePubKey]
+
+		if !ok {
+			unseen = append(unseen, nodePubKey)
+			continue
+		}
+
+		if connectedPeers[nodePubKey] || time.Since(seenAt) < inactivityWindow {
+			continue
+		}
+
+		manager.reapNode(nodePubKey, records, seenAt)
+	}
+
+	if len(unseen) != 0 {
+		if err := manager.database.SetPeersLastSeen(unseen, time.Now()); err != nil {
+			logger.Warning("Could not save when peers were seen: " + err.Error())
+		}
+	}
+}
+
+func (manager *ChannelManager) reapNode(nodePubKey string, records []database.ChannelRecord, seenAt time.Time) {
+	var closed []string
+	failed := false
+
+	for _, record := range records {
+		if manager.ctx.Err() != nil {
+			return
+		}
+
+		if record.GetState() == database.ChannelClosed {
+			continue
+		}
+
+		key := getOpenRequestKey(nodePubKey, record.Currency)
+
+		if err := manager.closeChannel(nodePubKey, record.Currency); err != nil {
+			if manager.ctx.Err() != nil {
+				return
+			}
+
+			message := "Could not close " + record.Currency + " channel to inactive node `" + nodePubKey + "`: " + err.Error()
+
+			logger.Warning(message)
+
+			// Retries are only reported when they fail with a different error
+			if manager.setCloseFailure(key, err.Error()) {
+				_ = manager.discord.SendMessage(message)
+			}
+
+			// The record is kept so that closing is retried the next time
+			failed = true
+			continue
+		}
+
+		manager.setCloseFailure(key, "")
+
+		// Persisted right away so that the channel is not closed again if a later one fails
+		manager.setChannelState(nodePubKey, record.Currency, database.ChannelClosed)
+		closed = append(closed, record.Currency)
+	}
+
+	if len(closed) != 0 {
+		message := "Closed " + strings.Join(closed, ", ") + " channels to `" + nodePubKey + "` which was last seen " +
+			time.Since(seenAt).Round(time.Hour).String() + " ago"
+
+		logger.Info(message)
+		_ = manager.discord.SendMessage(message)
+	}
+
+	if failed {
+		return
+	}
+
+	if err := manager.database.RemoveNode(nodePubKey); err != nil {
+		logger.Error("Could not remove records of node " + nodePubKey + ": " + err.Error())
+	}
+}
+
+// Records the last error of closing a channel or clears it if empty; returns whether the error changed
+func (manager *ChannelManager) setCloseFailure(key string, lastError string) bool {
+	manager.closeFailuresLock.Lock()
+	defer manager.closeFailuresLock.Unlock()
+
+	if manager.closeFailures == nil {
+		manager.closeFailures = map[string]string{}
+	}
+
+	changed := manager.closeFailures[key] != lastError
+
+	if lastError == "" {
+		delete(manager.closeFailures, key)
+	} else {
+		manager.closeFailures[key] = lastError
+	}
+
+	return changed
+}
+
+// Tries to close the channel cooperatively first and forcibly if that fails
+func (manager *ChannelManager) closeChannel(nodePubKey string, currency string) error {
+	_, err := manager.xud.CloseChannel(&xudrpc.CloseChannelRequest{
+		NodeIdentifier: nodePubKey,
+		Currency:       currency,
+	}, closeTimeout)
+
+	if err == nil {
+		return nil
+	}
+
+	logger.Info("Could not close " + currency + " channel to " + nodePubKey + " cooperatively: " + err.Error())
+
+	_, err = manager.xud.CloseChannel(&xudrpc.CloseChannelRequest{
+		NodeIdentifier: nodePubKey,
+		Currency:       currency,
+		Force:          true,
+	}, closeTimeout)
+
+	return err
+}
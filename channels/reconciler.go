@@ -0,0 +1,172 @@
+package channels
+
+import (
+	"github.com/ExchangeUnion/xud-simnet-bot/database"
+	"github.com/google/logger"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type recordedChannel struct {
+	nodePubKey string
+	record     database.ChannelRecord
+}
+
+// Compares the recorded channels with the channels the lnds of the XUD node actually have
+//
+// XUD does not expose the channels to a specific peer, so only the number of channels per currency can be compared.
+// Because those numbers also include channels that other nodes opened to us, the state of a record is only changed
+// when the numbers prove it for every record of the currency; all other mismatches are reported but left alone
+func (manager *ChannelManager) reconcileChannels() {
+	info, err := manager.xud.GetInfo()
+
+	if err != nil {
+		logger.Warning("Could not get XUD info to reconcile channels: " + err.Error())
+		return
+	}
+
+	allRecords, err := manager.database.GetAllChannelRecords()
+
+	if err != nil {
+		logger.Warning("Could not get recorded channels: " + err.Error())
+		return
+	}
+
+	for _, channel := range manager.getChannels() {
+		// XUD reports the Raiden channels of all tokens only in aggregate
+		if channel.raiden {
+			continue
+		}
+
+		lndInfo, ok := info.Lnd[channel.Currency]
+
+		if !ok || lndInfo.Channels == nil {
+			continue
+		}
+
+		var pending []recordedChannel
+		var open []recordedChannel
+
+		for nodePubKey, records := range allRecords {
+			for _, record := range records {
+				if record.Currency != channel.Currency {
+					continue
+				}
+
+				switch record.GetState() {
+				case database.ChannelPending:
+					pending = append(pending, recordedChannel{nodePubKey: nodePubKey, record: record})
+
+				case database.ChannelOpen:
+					open = append(open, recordedChannel{nodePubKey: nodePubKey, record: record})
+				}
+			}
+		}
+
+		confirmed := manager.reconcilePendingChannels(channel.Currency, pending, int(lndInfo.Channels.Pending))
+		open = append(open, confirmed...)
+
+		manager.reconcileOpenChannels(channel.Currency, open, int(lndInfo.Channels.Active+lndInfo.Channels.Inactive))
+	}
+}
+
+// Marks the pending channels as open once lnd has no pending channels at all anymore
+// Pending channels of other nodes could hide ours, so channels that are pending for too long are only reported
+func (manager *ChannelManager) reconcilePendingChannels(currency string, pending []recordedChannel, lndPending int) (confirmed []recordedChannel) {
+	if lndPending == 0 {
+		manager.clearAlert("pending:" + currency + ":")
+
+		for _, channel := range pending {
+			manager.setChannelState(channel.nodePubKey, currency, database.ChannelOpen)
+			confirmed = append(confirmed, channel)
+
+			logger.Info("Funding transaction of " + currency + " channel to " + channel.nodePubKey + " was confirmed")
+		}
+
+		return confirmed
+	}
+
+	for _, channel := range pending {
+		if time.Since(channel.record.OpenedAt) <= time.Duration(manager.PendingTimeout)*time.Second {
+			continue
+		}
+
+		manager.alertOnce("pending:"+currency+":"+channel.nodePubKey, "Funding transaction of "+currency+
+			" channel to `"+channel.nodePubKey+"` was not confirmed in "+strconv.Itoa(manager.PendingTimeout)+
+			" seconds; check it manually and reset the peer if it failed")
+	}
+
+	return confirmed
+}
+
+// Marks the open channels as closed once lnd has no open channels at all anymore
+// If lnd reports fewer open channels than recorded, the closed ones cannot be identified and the mismatch is only reported
+func (manager *ChannelManager) reconcileOpenChannels(currency string, open []recordedChannel, lndOpen int) {
+	missing := len(open) - lndOpen
+
+	if missing <= 0 {
+		manager.clearAlert("open:" + currency + ":")
+		return
+	}
+
+	if lndOpen == 0 {
+		for _, channel := range open {
+			manager.setChannelState(channel.nodePubKey, currency, database.ChannelClosed)
+			manager.notifyChannelClosed(currency, channel.nodePubKey, "lnd has no open channels anymore")
+		}
+
+		return
+	}
+
+	// The number is part of the key so that a change of the mismatch is reported again
+	manager.alertOnce("open:"+currency+":"+strconv.Itoa(missing), "lnd reports "+strconv.Itoa(missing)+" fewer open "+currency+
+		" channels than were opened; the closed ones cannot be identified, so check them manually and reset their peers")
+}
+
+// Sends an alert only the first time it is raised for the key
+func (manager *ChannelManager) alertOnce(key string, message string) {
+	manager.alertsLock.Lock()
+
+	if manager.alerts == nil {
+		manager.alerts = map[string]bool{}
+	}
+
+	alerted := manager.alerts[key]
+	manager.alerts[key] = true
+
+	manager.alertsLock.Unlock()
+
+	if alerted {
+		return
+	}
+
+	logger.Warning(message)
+	_ = manager.discord.SendMessage(message)
+}
+
+// Clears all alerts whose key starts with the prefix so that they are sent again when they are raised the next time
+func (manager *ChannelManager) clearAlert(prefix string) {
+	manager.alertsLock.Lock()
+	defer manager.alertsLock.Unlock()
+
+	for key := range manager.alerts {
+		if strings.HasPrefix(key, prefix) {
+			delete(manager.alerts, key)
+		}
+	}
+}
+
+func (manager *ChannelManager) setChannelState(nodePubKey string, currency string, state database.ChannelState) {
+	if err := manager.database.SetChannelState(nodePubKey, currency, state); err != nil {
+		logger.Error("Could not update state of " + currency + " channel to " + nodePubKey + ": " + err.Error())
+	}
+}
+
+func (manager *ChannelManager) notifyChannelClosed(currency string, nodePubKey string, reason string) {
+	message := "Considering " + currency + " channel to `" + nodePubKey + "` closed because " + reason +
+		"; it will be opened again in " + strconv.Itoa(manager.ReopenDelay) + " seconds"
+
+	logger.Warning(message)
+	_ = manager.discord.SendMessage(message)
+}
@@ -0,0 +1,89 @@
+package channels
+
+import (
+	"github.com/ExchangeUnion/xud-simnet-bot/database"
+	"github.com/ExchangeUnion/xud-simnet-bot/xudrpc"
+	"github.com/google/logger"
+	"strconv"
+	"time"
+)
+
+// Returns whether the backoff after the last failed attempt to open the channel is over
+func (manager *ChannelManager) shouldRetry(failure *database.ChannelFailure) bool {
+	if failure == nil {
+		return true
+	}
+
+	if failure.GivenUp {
+		return false
+	}
+
+	return time.Since(failure.LastAttempt) >= manager.getRetryDelay(failure.Attempts)
+}
+
+// The delay doubles with every failed attempt until it reaches the maximal delay
+func (manager *ChannelManager) getRetryDelay(attempts int) time.Duration {
+	delay := time.Duration(manager.RetryDelay) * time.Second
+	maxDelay := time.Duration(manager.MaxRetryDelay) * time.Second
+
+	for i := 1; i < attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > maxDelay {
+		return maxDelay
+	}
+
+	return delay
+}
+
+// Records a failed attempt and notifies Discord about the first failure, changed errors and when the bot gives up
+func (manager *ChannelManager) handleOpenFailure(peer *xudrpc.Peer, channel Channel, previous *database.ChannelFailure, err error) {
+	failure := database.ChannelFailure{
+		NodePubKey: peer.NodePubKey,
+		Currency:   channel.Currency,
+	}
+
+	if previous != nil {
+		failure = *previous
+	}
+
+	failure.Attempts += 1
+	failure.LastAttempt = time.Now()
+
+	errorChanged := failure.LastError != err.Error()
+	failure.LastError = err.Error()
+
+	if manager.MaxAttempts > 0 && failure.Attempts >= manager.MaxAttempts {
+		failure.GivenUp = true
+	}
+
+	if err := manager.database.SetChannelFailure(failure); err != nil {
+		logger.Error("Could not save failed attempt to open " + channel.Currency + " channel: " + err.Error())
+	}
+
+	nodeInfo := "**" + peer.Alias + "** (`" + peer.NodePubKey + "`)"
+	message := "Could not open " + channel.Currency + " channel to " + nodeInfo + ": " + err.Error()
+
+	if failure.GivenUp {
+		message += "\nGiving up after " + strconv.Itoa(failure.Attempts) + " attempts"
+	} else {
+		message += "\nRetrying in " + manager.getRetryDelay(failure.Attempts).String()
+	}
+
+	logger.Warning(message)
+
+	if failure.Attempts == 1 || errorChanged || failure.GivenUp {
+		_ = manager.discord.SendMessage(message)
+	}
+}
+
+func (manager *ChannelManager) clearFailure(peer *xudrpc.Peer, channel Channel, previous *database.ChannelFailure) {
+	if previous == nil {
+		return
+	}
+
+	if err := manager.database.RemoveChannelFailure(peer.NodePubKey, channel.Currency); err != nil {
+		logger.Error("Could not remove failed attempts to open " + channel.Currency + " channel: " + err.Error())
+	}
+}
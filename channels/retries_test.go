@@ -0,0 +1,32 @@
+package channels
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetRetryDelay(t *testing.T) {
+	manager := &ChannelManager{
+		RetryDelay:    60,
+		MaxRetryDelay: 600,
+	}
+
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{0, time.Minute},
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{4, 8 * time.Minute},
+		{5, 10 * time.Minute},
+		{100, 10 * time.Minute},
+	}
+
+	for _, test := range tests {
+		if delay := manager.getRetryDelay(test.attempts); delay != test.expected {
+			t.Errorf("getRetryDelay(%d) = %s; expected %s", test.attempts, delay, test.expected)
+		}
+	}
+}
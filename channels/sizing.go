@@ -0,0 +1,172 @@
+package channels
+
+import (
+	"errors"
+	"github.com/ExchangeUnion/xud-simnet-bot/database"
+	"github.com/ExchangeUnion/xud-simnet-bot/xudrpc"
+	"strconv"
+)
+
+type channelSize struct {
+	amount     float64
+	pushAmount float64
+}
+
+// Balances and trading limits of the XUD node that are queried at most once per check of a peer
+type nodeState struct {
+	xud *xudrpc.Xud
+
+	balances *xudrpc.GetBalanceResponse
+	limits   *xudrpc.TradingLimitsResponse
+}
+
+func (state *nodeState) getWalletBalance(currency string) (float64, error) {
+	if state.balances == nil {
+		balances, err := state.xud.GetBalance("")
+
+		if err != nil {
+			return 0, err
+		}
+
+		state.balances = balances
+	}
+
+	balance, ok := state.balances.Balances[currency]
+
+	if !ok {
+		return 0, errors.New("XUD did not return a balance for " + currency)
+	}
+
+	return satoshisToCoins(balance.WalletBalance), nil
+}
+
+func (state *nodeState) getMaxSell(currency string) (float64, error) {
+	if state.limits == nil {
+		limits, err := state.xud.TradingLimits("")
+
+		if err != nil {
+			return 0, err
+		}
+
+		state.limits = limits
+	}
+
+	limits, ok := state.limits.Limits[currency]
+
+	if !ok {
+		return 0, nil
+	}
+
+	return satoshisToCoins(limits.MaxSell), nil
+}
+
+// Calculates capacity and push amount of a new channel to the peer
+// The channel is scaled down to the limits of the wallet balance, the trading limits and the capacity cap per peer
+// and an error is returned if the channel would be smaller than its minimal amount after that
+func (manager *ChannelManager) getChannelSize(peer *xudrpc.Peer, channel Channel, committed float64, state *nodeState) (channelSize, error) {
+	size := channelSize{
+		amount:     channel.Amount,
+		pushAmount: channel.PushAmount,
+	}
+
+	if channel.PreferredAmount > 0 && manager.isPreferred(peer.NodePubKey) {
+		size.amount = channel.PreferredAmount
+		size.pushAmount = channel.PreferredPushAmount
+	}
+
+	if manager.WalletShare > 0 {
+		balance, err := state.getWalletBalance(channel.Currency)
+
+		if err != nil {
+			return size, errors.New("could not get wallet balance: " + err.Error())
+		}
+
+		size.scaleDown(balance * manager.WalletShare)
+	}
+
+	if manager.RespectTradingLimits {
+		maxSell, err := state.getMaxSell(channel.Currency)
+
+		if err != nil {
+			return size, errors.New("could not get trading limits: " + err.Error())
+		}
+
+		if maxSell > 0 {
+			size.scaleDown(maxSell)
+		}
+	}
+
+	if manager.MaxPeerCapacity > 0 {
+		rate := getReferenceRate(channel)
+		size.scaleDown((manager.MaxPeerCapacity - committed) / rate)
+	}
+
+	if size.amount <= 0 || size.amount < channel.MinAmount {
+		return size, errors.New("capacity of " + strconv.FormatFloat(size.amount, 'f', -1, 64) +
+			" is below the minimum of " + strconv.FormatFloat(channel.MinAmount, 'f', -1, 64))
+	}
+
+	return size, nil
+}
+
+// Returns the capacity of the channels to a peer that are not closed in the reference unit
+// Records of older versions have no amount and are assumed to have the configured amount
+func (manager *ChannelManager) getCommittedCapacity(records []database.ChannelRecord) float64 {
+	committed := 0.0
+
+	for _, record := range records {
+		if record.GetState() == database.ChannelClosed {
+			continue
+		}
+
+		for _, channel := range manager.getChannels() {
+			if channel.Currency != record.Currency {
+				continue
+			}
+
+			amount := record.Amount
+
+			if amount == 0 {
+				amount = channel.Amount
+			}
+
+			committed += amount * getReferenceRate(channel)
+		}
+	}
+
+	return committed
+}
+
+func (manager *ChannelManager) isPreferred(nodePubKey string) bool {
+	for _, preferred := range manager.PreferredList {
+		if preferred == nodePubKey {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Scales the capacity down to the maximum and the push amount proportionally
+func (size *channelSize) scaleDown(maxAmount float64) {
+	if size.amount <= maxAmount {
+		return
+	}
+
+	if maxAmount <= 0 {
+		size.amount = 0
+		size.pushAmount = 0
+		return
+	}
+
+	size.pushAmount = size.pushAmount * maxAmount / size.amount
+	size.amount = maxAmount
+}
+
+func getReferenceRate(channel Channel) float64 {
+	if channel.ReferenceRate <= 0 {
+		return 1
+	}
+
+	return channel.ReferenceRate
+}
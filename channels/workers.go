@@ -0,0 +1,144 @@
+package channels
+
+import (
+	"errors"
+	"github.com/ExchangeUnion/xud-simnet-bot/database"
+	"github.com/ExchangeUnion/xud-simnet-bot/xudrpc"
+	"strings"
+)
+
+// Number of requests that can be queued per worker before new ones are deferred to the next tick
+const openRequestsPerWorker = 16
+
+// Relative tolerance for rounding errors of the summed up capacity of channels to a peer
+const capacityTolerance = 1e-9
+
+var errAlreadyQueued = errors.New("channel is queued or being opened already")
+
+type openRequest struct {
+	peer    *xudrpc.Peer
+	channel Channel
+	size    channelSize
+	failure *database.ChannelFailure
+}
+
+// Starts the workers that open the queued channels concurrently
+// That way a single slow call to XUD does not block the channels of all other peers
+func (manager *ChannelManager) startWorkers() {
+	concurrency := manager.Concurrency
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	manager.inFlight = map[string]float64{}
+	manager.openRequests = make(chan openRequest, concurrency*openRequestsPerWorker)
+
+	manager.workers.Add(concurrency)
+
+	for i := 0; i < concurrency; i++ {
+		go manager.runWorker()
+	}
+}
+
+func (manager *ChannelManager) runWorker() {
+	defer manager.workers.Done()
+
+	for {
+		select {
+		case <-manager.ctx.Done():
+			return
+
+		case request := <-manager.openRequests:
+			manager.openChannel(request.peer, request.channel, request.size, request.failure)
+			manager.finishOpenRequest(request)
+		}
+	}
+}
+
+// Queues a channel to be opened unless the same channel is queued or being opened already
+func (manager *ChannelManager) queueOpenRequest(
+	peer *xudrpc.Peer,
+	channel Channel,
+	failure *database.ChannelFailure,
+	records []database.ChannelRecord,
+	state *nodeState,
+) error {
+	key := getOpenRequestKey(peer.NodePubKey, channel.Currency)
+
+	manager.inFlightLock.Lock()
+
+	if _, ok := manager.inFlight[key]; ok {
+		manager.inFlightLock.Unlock()
+		return errAlreadyQueued
+	}
+
+	committed := manager.getPeerCommittedCapacity(peer.NodePubKey, records)
+	manager.inFlightLock.Unlock()
+
+	// Sizing the channel queries XUD which must not block the workers that finish their requests
+	size, err := manager.getChannelSize(peer, channel, committed, state)
+
+	if err != nil {
+		return err
+	}
+
+	manager.inFlightLock.Lock()
+	defer manager.inFlightLock.Unlock()
+
+	// The same channel could have been queued while it was sized
+	if _, ok := manager.inFlight[key]; ok {
+		return errAlreadyQueued
+	}
+
+	capacity := size.amount * getReferenceRate(channel)
+
+	// Other channels to the peer could have been queued or opened while it was sized
+	if manager.MaxPeerCapacity > 0 {
+		records, err := manager.database.GetChannelRecords(peer.NodePubKey)
+
+		if err != nil {
+			return err
+		}
+
+		committed = manager.getPeerCommittedCapacity(peer.NodePubKey, records)
+
+		if committed+capacity > manager.MaxPeerCapacity*(1+capacityTolerance) {
+			return errors.New("capacity cap of the peer was reached while the channel was sized")
+		}
+	}
+
+	select {
+	case manager.openRequests <- openRequest{peer: peer, channel: channel, size: size, failure: failure}:
+		manager.inFlight[key] = capacity
+		return nil
+
+	default:
+		return errors.New("queue of channels to open is full")
+	}
+}
+
+// Capacity of the recorded channels to the peer and the ones that are queued or being opened; requires the lock of the
+// channels in flight
+func (manager *ChannelManager) getPeerCommittedCapacity(nodePubKey string, records []database.ChannelRecord) float64 {
+	committed := manager.getCommittedCapacity(records)
+
+	for inFlightKey, value := range manager.inFlight {
+		if strings.HasPrefix(inFlightKey, nodePubKey+"/") {
+			committed += value
+		}
+	}
+
+	return committed
+}
+
+func (manager *ChannelManager) finishOpenRequest(request openRequest) {
+	manager.inFlightLock.Lock()
+	defer manager.inFlightLock.Unlock()
+
+	delete(manager.inFlight, getOpenRequestKey(request.peer.NodePubKey, request.channel.Currency))
+}
+
+func getOpenRequestKey(nodePubKey string, currency string) string {
+	return nodePubKey + "/" + currency
+}
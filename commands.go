@@ -0,0 +1,51 @@
+package main
+
+import (
+	"errors"
+	"github.com/ExchangeUnion/xud-simnet-bot/xudrpc"
+	"sort"
+	"strconv"
+)
+
+func registerCommands(cfg *config) {
+	cfg.Discord.AddCommand("status", "", "Shows information about the XUD node of the bot and its balances", func(_ string, _ []string) (string, error) {
+		return getStatus(cfg.Xud)
+	})
+}
+
+func getStatus(xud *xudrpc.Xud) (string, error) {
+	info, err := xud.GetInfo()
+
+	if err != nil {
+		return "", errors.New("could not get XUD info: " + err.Error())
+	}
+
+	balances, err := xud.GetBalance("")
+
+	if err != nil {
+		return "", errors.New("could not get XUD balances: " + err.Error())
+	}
+
+	status := "XUD node: **" + info.Alias + "** (`" + info.NodePubKey + "`)\nBalances:"
+
+	var currencies []string
+
+	for currency := range balances.Balances {
+		currencies = append(currencies, currency)
+	}
+
+	sort.Strings(currencies)
+
+	for _, currency := range currencies {
+		balance := balances.Balances[currency]
+
+		status += "\n- " + currency + ": " + formatSatoshis(balance.ChannelBalance) + " in channels, " +
+			formatSatoshis(balance.WalletBalance) + " in wallet"
+	}
+
+	return status, nil
+}
+
+func formatSatoshis(satoshis uint64) string {
+	return strconv.FormatFloat(float64(satoshis)/1e8, 'f', -1, 64)
+}
@@ -1,16 +1,21 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/BurntSushi/toml"
+	"github.com/ExchangeUnion/xud-simnet-bot/admin"
 	"github.com/ExchangeUnion/xud-simnet-bot/build"
 	"github.com/ExchangeUnion/xud-simnet-bot/channels"
 	"github.com/ExchangeUnion/xud-simnet-bot/database"
 	"github.com/ExchangeUnion/xud-simnet-bot/discord"
 	"github.com/ExchangeUnion/xud-simnet-bot/faucet"
+	"github.com/ExchangeUnion/xud-simnet-bot/metrics"
+	"github.com/ExchangeUnion/xud-simnet-bot/monitor"
 	"github.com/ExchangeUnion/xud-simnet-bot/xudrpc"
 	"github.com/jessevdk/go-flags"
 	"os"
+	"sync"
 )
 
 type helpOptions struct {
@@ -19,8 +24,9 @@ type helpOptions struct {
 }
 
 type config struct {
-	ConfigFile string `short:"c" long:"configfile" description:"Path to configuration file"`
-	LogFile    string `short:"l" long:"logfile" description:"Path to the log file"`
+	ConfigFile     string `short:"c" long:"configfile" description:"Path to configuration file"`
+	ReloadInterval int    `long:"reloadinterval" default:"10" description:"Interval in seconds at which the configuration file is checked for changes of the channels; 0 disables it but the channels are still reloaded on SIGHUP"`
+	LogFile        string `short:"l" long:"logfile" description:"Path to the log file"`
 
 	Xud     *xudrpc.Xud      `group:"XUD Options"`
 	Discord *discord.Discord `group:"Discord Options"`
@@ -31,8 +37,21 @@ type config struct {
 	Faucet   *faucet.Faucet   `group:"Faucet"`
 	Ethereum *faucet.Ethereum `group:"Ethereum"`
 
-	// This option is only parsed in the TOML config file
-	Channels []*channels.Channel
+	Metrics *metrics.Metrics `group:"Metrics"`
+	Admin   *admin.Admin     `group:"Admin API"`
+
+	BalanceMonitor *monitor.BalanceMonitor `group:"Balance Monitor Options"`
+
+	// These options are only parsed in the TOML config file
+	LightningChannels []*channels.Channel
+	RaidenChannels    []*channels.RaidenChannel
+	FaucetAssets      []*faucet.Asset
+
+	// Deprecated: lightning channels and faucet assets in a single section
+	Channels []*legacyChannel
+
+	// Guards the sections that are replaced when the config file is reloaded
+	lock sync.RWMutex
 
 	Help *helpOptions `group:"Help Options"`
 }
@@ -43,7 +62,8 @@ func loadConfig() *config {
 		ConfigFile: "./xud-simnet-bot.toml",
 
 		Database: &database.Database{
-			FileName: "./xud-simnet-bot.json",
+			FileName:       "./xud-simnet-bot.db",
+			LegacyFileName: "./xud-simnet-bot.json",
 		},
 
 		Faucet: &faucet.Faucet{
@@ -53,6 +73,14 @@ func loadConfig() *config {
 		Ethereum: &faucet.Ethereum{
 			RPCHost: "http://130.211.223.61:8545",
 		},
+
+		Metrics: &metrics.Metrics{
+			Port: 9001,
+		},
+
+		Admin: &admin.Admin{
+			Host: "127.0.0.1",
+		},
 	}
 
 	parser := flags.NewParser(&cfg, flags.IgnoreUnknown)
@@ -75,8 +103,11 @@ func loadConfig() *config {
 	if cfg.ConfigFile != "" {
 		_, err := toml.DecodeFile(cfg.ConfigFile, &cfg)
 
-		if err != nil {
-			fmt.Println("Could not read config file: " + err.Error())
+		// Only CLI flags are used if the default config file does not exist
+		if os.IsNotExist(err) && !parser.FindOptionByLongName("configfile").IsSet() {
+			cfg.ConfigFile = ""
+		} else if err != nil {
+			printFatal("Could not read config file: %s", err)
 		}
 	}
 
@@ -89,6 +120,45 @@ func loadConfig() *config {
 	return &cfg
 }
 
+// Paths of the options that must not be logged or shown in the admin API
+var secretOptions = [][]string{
+	{"Discord", "Token"},
+	{"Ethereum", "Password"},
+	{"Admin", "Token"},
+	{"Faucet", "CaptchaSecret"},
+}
+
+// Returns the config as generic JSON object in which all secrets that are set are replaced
+func (cfg *config) redacted() (map[string]interface{}, error) {
+	cfg.lock.RLock()
+	data, err := json.Marshal(cfg)
+	cfg.lock.RUnlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var redacted map[string]interface{}
+
+	if err := json.Unmarshal(data, &redacted); err != nil {
+		return nil, err
+	}
+
+	for _, path := range secretOptions {
+		group, ok := redacted[path[0]].(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if value, ok := group[path[1]].(string); ok && value != "" {
+			group[path[1]] = "redacted"
+		}
+	}
+
+	return redacted, nil
+}
+
 func printCouldNotParseCli(err error) {
 	printFatal("Could not parse CLI arguments: %s", err)
 }
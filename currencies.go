@@ -0,0 +1,143 @@
+package main
+
+import (
+	"errors"
+	"github.com/ExchangeUnion/xud-simnet-bot/channels"
+	"github.com/ExchangeUnion/xud-simnet-bot/faucet"
+	"github.com/ExchangeUnion/xud-simnet-bot/xudrpc"
+	"github.com/google/logger"
+	"strconv"
+	"strings"
+)
+
+// Entry of the deprecated "Channels" section of the config file
+// Entries with a token address or the currency "ETH" are faucet assets and all others lightning channels
+type legacyChannel struct {
+	Currency     string
+	TokenAddress string
+	Amount       float64
+	PushAmount   float64
+	MinBalance   float64
+
+	PreferredAmount     float64
+	PreferredPushAmount float64
+	MinAmount           float64
+	ReferenceRate       float64
+}
+
+type currencyConfig struct {
+	lightningChannels []channels.Channel
+	raidenChannels    []channels.RaidenChannel
+	faucetAssets      []faucet.Asset
+}
+
+// Merges the explicit sections of the config with the deprecated "Channels" section
+func (cfg *config) getCurrencyConfig() *currencyConfig {
+	currencies := &currencyConfig{}
+
+	for _, channel := range cfg.LightningChannels {
+		currencies.lightningChannels = append(currencies.lightningChannels, *channel)
+	}
+
+	for _, channel := range cfg.RaidenChannels {
+		currencies.raidenChannels = append(currencies.raidenChannels, *channel)
+	}
+
+	for _, asset := range cfg.FaucetAssets {
+		currencies.faucetAssets = append(currencies.faucetAssets, *asset)
+	}
+
+	if len(cfg.Channels) != 0 {
+		logger.Warning("The \"Channels\" section of the config is deprecated; use \"LightningChannels\" and \"FaucetAssets\" instead")
+	}
+
+	for _, entry := range cfg.Channels {
+		if entry.TokenAddress == "" && entry.Currency != faucet.EtherCurrency {
+			currencies.lightningChannels = append(currencies.lightningChannels, channels.Channel{
+				Currency:            entry.Currency,
+				Amount:              entry.Amount,
+				PushAmount:          entry.PushAmount,
+				MinBalance:          entry.MinBalance,
+				PreferredAmount:     entry.PreferredAmount,
+				PreferredPushAmount: entry.PreferredPushAmount,
+				MinAmount:           entry.MinAmount,
+				ReferenceRate:       entry.ReferenceRate,
+			})
+		} else {
+			currencies.faucetAssets = append(currencies.faucetAssets, faucet.Asset{
+				Currency:     entry.Currency,
+				TokenAddress: entry.TokenAddress,
+				Amount:       entry.Amount,
+				MinBalance:   entry.MinBalance,
+			})
+		}
+	}
+
+	return currencies
+}
+
+// Completes the faucet assets whose decimals are not configured with the ones of their token
+func (currencies *currencyConfig) resolveDecimals(xud *xudrpc.Xud, eth *faucet.Ethereum) error {
+	response, err := xud.ListCurrencies()
+
+	if err != nil {
+		return errors.New("could not get currencies of XUD: " + err.Error())
+	}
+
+	decimalPlaces := map[string]uint32{}
+
+	for _, currency := range response.Currencies {
+		decimalPlaces[currency.Currency] = currency.DecimalPlaces
+	}
+
+	for i := range currencies.faucetAssets {
+		asset := &currencies.faucetAssets[i]
+
+		if err := asset.ResolveDecimals(eth, decimalPlaces[asset.Currency]); err != nil {
+			return errors.New("faucet asset " + asset.Currency + ": " + err.Error())
+		}
+
+		if _, err := asset.GetBaseAmount(); err != nil {
+			return errors.New("faucet asset " + asset.Currency + ": " + err.Error())
+		}
+
+		if !asset.IsEther() {
+			logger.Info("Using " + strconv.Itoa(int(asset.Decimals)) + " decimals for " + asset.Currency)
+		}
+	}
+
+	return nil
+}
+
+// Swaps the currencies of all services that use them
+func (currencies *currencyConfig) apply(cfg *config) {
+	nodeChannels := append([]channels.Channel{}, currencies.lightningChannels...)
+
+	for _, channel := range currencies.raidenChannels {
+		nodeChannels = append(nodeChannels, channel.ToChannel())
+	}
+
+	cfg.ChannelManager.SetChannels(nodeChannels)
+	cfg.Faucet.SetAssets(currencies.faucetAssets)
+	cfg.BalanceMonitor.SetCurrencies(currencies.faucetAssets, nodeChannels)
+}
+
+func (currencies *currencyConfig) String() string {
+	var lightning, raiden, assets []string
+
+	for _, channel := range currencies.lightningChannels {
+		lightning = append(lightning, channel.Currency)
+	}
+
+	for _, channel := range currencies.raidenChannels {
+		raiden = append(raiden, channel.Currency)
+	}
+
+	for _, asset := range currencies.faucetAssets {
+		assets = append(assets, asset.Currency)
+	}
+
+	return "lightning channels: " + strings.Join(lightning, ", ") +
+		"; Raiden channels: " + strings.Join(raiden, ", ") +
+		"; faucet assets: " + strings.Join(assets, ", ")
+}
@@ -0,0 +1,19 @@
+package database
+
+// Transactional key/value store in which the database persists its data
+type Backend interface {
+	// Executes the callback in a read-write transaction which is rolled back if the callback returns an error
+	Update(callback func(tx Tx) error) error
+	// Executes the callback in a read-only transaction
+	View(callback func(tx Tx) error) error
+
+	Close() error
+}
+
+// Keys are grouped in buckets and reading a key or bucket that does not exist yields no error
+type Tx interface {
+	Get(bucket string, key string) []byte
+	Put(bucket string, key string, value []byte) error
+	Delete(bucket string, key string) error
+	ForEach(bucket string, callback func(key string, value []byte) error) error
+}
@@ -0,0 +1,92 @@
+package database
+
+import (
+	bolt "go.etcd.io/bbolt"
+	"time"
+)
+
+type boltBackend struct {
+	db *bolt.DB
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+func openBoltBackend(fileName string) (*boltBackend, error) {
+	db, err := bolt.Open(fileName, 0600, &bolt.Options{
+		// Fail instead of blocking forever if another instance of the bot holds the lock on the file
+		Timeout: 5 * time.Second,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &boltBackend{db: db}, nil
+}
+
+func (backend *boltBackend) Update(callback func(tx Tx) error) error {
+	return backend.db.Update(func(tx *bolt.Tx) error {
+		return callback(&boltTx{tx: tx})
+	})
+}
+
+func (backend *boltBackend) View(callback func(tx Tx) error) error {
+	return backend.db.View(func(tx *bolt.Tx) error {
+		return callback(&boltTx{tx: tx})
+	})
+}
+
+func (backend *boltBackend) Close() error {
+	return backend.db.Close()
+}
+
+func (tx *boltTx) Get(bucket string, key string) []byte {
+	boltBucket := tx.tx.Bucket([]byte(bucket))
+
+	if boltBucket == nil {
+		return nil
+	}
+
+	value := boltBucket.Get([]byte(key))
+
+	if value == nil {
+		return nil
+	}
+
+	// Values returned by bolt are only valid for the lifetime of the transaction
+	return append([]byte{}, value...)
+}
+
+func (tx *boltTx) Put(bucket string, key string, value []byte) error {
+	boltBucket, err := tx.tx.CreateBucketIfNotExists([]byte(bucket))
+
+	if err != nil {
+		return err
+	}
+
+	return boltBucket.Put([]byte(key), value)
+}
+
+func (tx *boltTx) Delete(bucket string, key string) error {
+	boltBucket := tx.tx.Bucket([]byte(bucket))
+
+	if boltBucket == nil {
+		return nil
+	}
+
+	return boltBucket.Delete([]byte(key))
+}
+
+func (tx *boltTx) ForEach(bucket string, callback func(key string, value []byte) error) error {
+	boltBucket := tx.tx.Bucket([]byte(bucket))
+
+	if boltBucket == nil {
+		return nil
+	}
+
+	return boltBucket.ForEach(func(key []byte, value []byte) error {
+		return callback(string(key), append([]byte{}, value...))
+	})
+}
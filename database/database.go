@@ -3,56 +3,524 @@ package database
 import (
 	"encoding/json"
 	"github.com/google/logger"
-	"os"
+	"sort"
+	"time"
 )
 
 type Database struct {
-	FileName string `long:"database.file" description:"File in which information about opened channels should be stored"`
+	FileName       string `long:"database.file" description:"File in which information about opened channels and faucet claims should be stored"`
+	LegacyFileName string `long:"database.legacyfile" description:"JSON database file of older versions that should be migrated on startup"`
 
-	// Map between XUD identity public keys and an array of strings that contains the currencies on which a channel was opened
-	channelsOpened map[string][]string
+	backend Backend
+}
+
+type ChannelState string
 
-	file *os.File
+const (
+	// The funding transaction of the channel was not confirmed yet
+	ChannelPending ChannelState = "pending"
+	ChannelOpen    ChannelState = "open"
+	// The channel was closed cooperatively or forcibly after it was opened
+	ChannelClosed ChannelState = "closed"
+)
+
+// Information about a channel that was opened to a XUD node
+type ChannelRecord struct {
+	Currency string       `json:"currency"`
+	State    ChannelState `json:"state,omitempty"`
+	// Capacity of the channel in whole coins; zero for records written by older versions
+	Amount   float64   `json:"amount,omitempty"`
+	OpenedAt time.Time `json:"openedAt"`
+	// Time at which the state of the channel changed the last time
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-func (database *Database) Init() {
+const (
+	// Map between XUD identity public keys and an array of the channels that were opened to that node
+	channelsBucket = "channels"
+
+	// Map between XUD identity public keys and the failed attempts to open channels to that node per currency
+	channelFailuresBucket = "channelFailures"
+
+	// Map between XUD identity public keys and the UNIX timestamp at which the node was a connected peer the last time
+	peersLastSeenBucket = "peersLastSeen"
+
+	// Map between identifiers of faucet claimants (addresses and IPs) and the UNIX timestamp of their last claim
+	faucetClaimsBucket = "faucetClaims"
+
+	// Map between the IDs of faucet requests and their state and transfers
+	faucetRequestsBucket = "faucetRequests"
+
+	// Index of the IDs of the faucet requests that are queued or being processed so that they can be read without
+	// decoding the finished ones; the values are the times at which the requests were created
+	pendingFaucetRequestsBucket = "pendingFaucetRequests"
+
+	metaBucket = "meta"
+
+	// Address of the disperse contract that was deployed by the faucet
+	disperseAddressKey = "disperseAddress"
+)
+
+func (database *Database) Init() (err error) {
 	logger.Info("Starting database with file location: " + database.FileName)
 
-	file, _ := os.OpenFile(database.FileName, os.O_RDWR, 0644)
-	database.file = file
+	database.backend, err = openBoltBackend(database.FileName)
+
+	if err != nil {
+		return err
+	}
+
+	return database.migrateLegacyFile()
+}
+
+func (database *Database) Close() error {
+	return database.backend.Close()
+}
+
+// Records that an attempt to open a channel succeeded; an existing record of a closed channel in that currency is replaced
+// Channels whose funding transaction still has to be confirmed are recorded as pending
+func (database *Database) AddChannelsOpened(nodePubKey string, currency string, amount float64, state ChannelState) error {
+	return database.backend.Update(func(tx Tx) error {
+		records, err := getChannelRecords(tx, nodePubKey)
+
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		record := ChannelRecord{
+			Currency:  currency,
+			State:     state,
+			Amount:    amount,
+			OpenedAt:  now,
+			UpdatedAt: now,
+		}
+
+		replaced := false
+
+		for i := range records {
+			if records[i].Currency == currency {
+				records[i] = record
+				replaced = true
+			}
+		}
+
+		if !replaced {
+			records = append(records, record)
+		}
+
+		return putJSON(tx, channelsBucket, nodePubKey, records)
+	})
+}
+
+func (database *Database) GetChannelRecords(nodePubKey string) (records []ChannelRecord, err error) {
+	err = database.backend.View(func(tx Tx) error {
+		records, err = getChannelRecords(tx, nodePubKey)
+		return err
+	})
+
+	return records, err
+}
+
+// Returns a map between XUD identity public keys and the channels that were opened to them
+func (database *Database) GetAllChannelRecords() (map[string][]ChannelRecord, error) {
+	allRecords := map[string][]ChannelRecord{}
+
+	err := database.backend.View(func(tx Tx) error {
+		return tx.ForEach(channelsBucket, func(nodePubKey string, value []byte) error {
+			var records []ChannelRecord
+
+			if err := json.Unmarshal(value, &records); err != nil {
+				return err
+			}
+
+			allRecords[nodePubKey] = records
+			return nil
+		})
+	})
 
-	logger.Info("Opening database file")
+	return allRecords, err
+}
+
+func (database *Database) SetChannelState(nodePubKey string, currency string, state ChannelState) error {
+	return database.backend.Update(func(tx Tx) error {
+		records, err := getChannelRecords(tx, nodePubKey)
+
+		if err != nil {
+			return err
+		}
+
+		for i := range records {
+			if records[i].Currency == currency {
+				records[i].State = state
+				records[i].UpdatedAt = time.Now()
+			}
+		}
+
+		return putJSON(tx, channelsBucket, nodePubKey, records)
+	})
+}
+
+// Failed attempts to open a channel since the last successful one
+type ChannelFailure struct {
+	NodePubKey  string    `json:"nodePubKey"`
+	Currency    string    `json:"currency"`
+	Attempts    int       `json:"attempts"`
+	LastAttempt time.Time `json:"lastAttempt"`
+	LastError   string    `json:"lastError"`
+	// Set once the maximal number of attempts was reached; no more attempts are made after that
+	GivenUp bool `json:"givenUp"`
+}
+
+// Returns a map between currencies and the failed attempts to open a channel in them to the node
+func (database *Database) GetChannelFailures(nodePubKey string) (failures map[string]ChannelFailure, err error) {
+	err = database.backend.View(func(tx Tx) error {
+		failures, err = getChannelFailures(tx, nodePubKey)
+		return err
+	})
+
+	return failures, err
+}
+
+func (database *Database) GetAllChannelFailures() ([]ChannelFailure, error) {
+	var allFailures []ChannelFailure
+
+	err := database.backend.View(func(tx Tx) error {
+		return tx.ForEach(channelFailuresBucket, func(_ string, value []byte) error {
+			var failures map[string]ChannelFailure
+
+			if err := json.Unmarshal(value, &failures); err != nil {
+				return err
+			}
+
+			for _, failure := range failures {
+				allFailures = append(allFailures, failure)
+			}
+
+			return nil
+		})
+	})
+
+	return allFailures, err
+}
+
+func (database *Database) SetChannelFailure(failure ChannelFailure) error {
+	return database.backend.Update(func(tx Tx) error {
+		failures, err := getChannelFailures(tx, failure.NodePubKey)
+
+		if err != nil {
+			return err
+		}
 
-	if err := json.NewDecoder(database.file).Decode(&database.channelsOpened); err != nil {
-		logger.Info("Could not open database file. Starting from scratch")
-		database.channelsOpened = map[string][]string{}
+		failures[failure.Currency] = failure
 
-		createdFile, err := os.Create(database.FileName)
+		return putJSON(tx, channelFailuresBucket, failure.NodePubKey, failures)
+	})
+}
+
+func (database *Database) RemoveChannelFailure(nodePubKey string, currency string) error {
+	return database.backend.Update(func(tx Tx) error {
+		failures, err := getChannelFailures(tx, nodePubKey)
 
 		if err != nil {
-			logger.Fatal("Could not create database file: " + err.Error())
+			return err
+		}
+
+		delete(failures, currency)
+
+		if len(failures) == 0 {
+			return tx.Delete(channelFailuresBucket, nodePubKey)
 		}
 
-		database.file = createdFile
+		return putJSON(tx, channelFailuresBucket, nodePubKey, failures)
+	})
+}
+
+func (database *Database) SetPeersLastSeen(nodePubKeys []string, lastSeen time.Time) error {
+	return database.backend.Update(func(tx Tx) error {
+		for _, nodePubKey := range nodePubKeys {
+			if err := putJSON(tx, peersLastSeenBucket, nodePubKey, lastSeen.Unix()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
 
-		database.write()
+// Returns a map between XUD identity public keys and the time at which they were connected the last time
+func (database *Database) GetAllPeersLastSeen() (map[string]time.Time, error) {
+	lastSeen := map[string]time.Time{}
+
+	err := database.backend.View(func(tx Tx) error {
+		return tx.ForEach(peersLastSeenBucket, func(nodePubKey string, value []byte) error {
+			var timestamp int64
+
+			if err := json.Unmarshal(value, &timestamp); err != nil {
+				return err
+			}
+
+			lastSeen[nodePubKey] = time.Unix(timestamp, 0)
+			return nil
+		})
+	})
+
+	return lastSeen, err
+}
+
+// Removes the recorded channels, failures and last seen time of a node so that it is treated like a new one
+func (database *Database) RemoveNode(nodePubKey string) error {
+	return database.backend.Update(func(tx Tx) error {
+		for _, bucket := range []string{channelsBucket, channelFailuresBucket, peersLastSeenBucket} {
+			if err := tx.Delete(bucket, nodePubKey); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Records written by older versions have no state and are assumed to be open
+func (record *ChannelRecord) GetState() ChannelState {
+	if record.State == "" {
+		return ChannelOpen
 	}
+
+	return record.State
+}
+
+// Returns the time of the last faucet claim of the identifier or the zero time if it never claimed
+func (database *Database) GetFaucetClaim(identifier string) (claimedAt time.Time, err error) {
+	err = database.backend.View(func(tx Tx) error {
+		var timestamp int64
+		found, err := getJSON(tx, faucetClaimsBucket, identifier, &timestamp)
+
+		if found {
+			claimedAt = time.Unix(timestamp, 0)
+		}
+
+		return err
+	})
+
+	return claimedAt, err
+}
+
+// Records the claims of all identifiers in a single transaction
+func (database *Database) SetFaucetClaims(identifiers []string, claimedAt time.Time) error {
+	return database.backend.Update(func(tx Tx) error {
+		for _, identifier := range identifiers {
+			if err := putJSON(tx, faucetClaimsBucket, identifier, claimedAt.Unix()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (database *Database) RemoveFaucetClaims(identifiers []string) error {
+	return database.backend.Update(func(tx Tx) error {
+		for _, identifier := range identifiers {
+			if err := tx.Delete(faucetClaimsBucket, identifier); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 }
 
-func (database *Database) AddChannelsOpened(nodePubKey string, currency string) {
-	database.channelsOpened[nodePubKey] = append(database.channelsOpened[nodePubKey], currency)
-	database.write()
+// Returns an empty string if no disperse contract was deployed yet
+func (database *Database) GetDisperseAddress() (address string, err error) {
+	err = database.backend.View(func(tx Tx) error {
+		_, err := getJSON(tx, metaBucket, disperseAddressKey, &address)
+		return err
+	})
+
+	return address, err
+}
+
+func (database *Database) SetDisperseAddress(address string) error {
+	return database.backend.Update(func(tx Tx) error {
+		return putJSON(tx, metaBucket, disperseAddressKey, address)
+	})
+}
+
+type FaucetRequestState string
+
+const (
+	FaucetRequestQueued FaucetRequestState = "queued"
+	// The transactions of the request are being sent
+	FaucetRequestProcessing FaucetRequestState = "processing"
+	// At least one of the currencies was sent
+	FaucetRequestDone   FaucetRequestState = "done"
+	FaucetRequestFailed FaucetRequestState = "failed"
+)
+
+// Request for tokens that was accepted by the faucet and is sent by its dispatcher
+type FaucetRequest struct {
+	ID      string             `json:"id"`
+	Address string             `json:"address"`
+	State   FaucetRequestState `json:"state"`
+	// Rate limiting identifier of the IP or Discord user that sent the request
+	Client string `json:"client,omitempty"`
+	// Whether the request was sent with a Discord command
+	Discord bool `json:"discord,omitempty"`
+
+	Transfers []FaucetTransfer `json:"transfers,omitempty"`
+	Error     string           `json:"error,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-func (database *Database) GetChannelsOpened(nodePubKey string) []string {
-	return database.channelsOpened[nodePubKey]
+type FaucetTransfer struct {
+	Currency string `json:"currency"`
+	// Amount in the smallest unit of the currency
+	Amount          string `json:"amount"`
+	TransactionHash string `json:"transactionHash,omitempty"`
+	Status          string `json:"status"`
+	Error           string `json:"error,omitempty"`
 }
 
-func (database *Database) write() {
-	jsonMap, _ := json.MarshalIndent(database.channelsOpened, "", "  ")
-	_, err := database.file.WriteAt(jsonMap, 0)
+// Adds a new request or replaces the existing one with the same ID
+func (database *Database) SetFaucetRequest(request FaucetRequest) error {
+	request.UpdatedAt = time.Now()
+
+	return database.backend.Update(func(tx Tx) error {
+		if err := putJSON(tx, faucetRequestsBucket, request.ID, request); err != nil {
+			return err
+		}
+
+		if request.IsPending() {
+			return putJSON(tx, pendingFaucetRequestsBucket, request.ID, request.CreatedAt)
+		}
+
+		return tx.Delete(pendingFaucetRequestsBucket, request.ID)
+	})
+}
+
+func (request *FaucetRequest) IsPending() bool {
+	return request.State == FaucetRequestQueued || request.State == FaucetRequestProcessing
+}
+
+// Returns nil if there is no request with that ID
+func (database *Database) GetFaucetRequest(id string) (request *FaucetRequest, err error) {
+	err = database.backend.View(func(tx Tx) error {
+		var stored FaucetRequest
+		found, err := getJSON(tx, faucetRequestsBucket, id, &stored)
+
+		if found {
+			request = &stored
+		}
+
+		return err
+	})
+
+	return request, err
+}
+
+// Returns the requests that are queued or being processed in the order in which they were created
+func (database *Database) GetPendingFaucetRequests() ([]FaucetRequest, error) {
+	var pending []FaucetRequest
+
+	err := database.backend.View(func(tx Tx) error {
+		return tx.ForEach(pendingFaucetRequestsBucket, func(id string, _ []byte) error {
+			var request FaucetRequest
+			found, err := getJSON(tx, faucetRequestsBucket, id, &request)
+
+			if found {
+				pending = append(pending, request)
+			}
+
+			return err
+		})
+	})
+
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].CreatedAt.Before(pending[j].CreatedAt)
+	})
+
+	return pending, err
+}
+
+func (database *Database) CountPendingFaucetRequests() (count int, err error) {
+	err = database.backend.View(func(tx Tx) error {
+		return tx.ForEach(pendingFaucetRequestsBucket, func(_ string, _ []byte) error {
+			count++
+			return nil
+		})
+	})
+
+	return count, err
+}
+
+// Removes the finished requests that were updated the last time before the given time
+func (database *Database) PruneFaucetRequests(before time.Time) (pruned int, err error) {
+	err = database.backend.Update(func(tx Tx) error {
+		var ids []string
+
+		err := tx.ForEach(faucetRequestsBucket, func(id string, value []byte) error {
+			var request FaucetRequest
+
+			if err := json.Unmarshal(value, &request); err != nil {
+				return err
+			}
+
+			if !request.IsPending() && request.UpdatedAt.Before(before) {
+				ids = append(ids, id)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		// Keys must not be deleted while iterating over the bucket
+		for _, id := range ids {
+			if err := tx.Delete(faucetRequestsBucket, id); err != nil {
+				return err
+			}
+		}
+
+		pruned = len(ids)
+		return nil
+	})
+
+	return pruned, err
+}
+
+func getChannelRecords(tx Tx, nodePubKey string) (records []ChannelRecord, err error) {
+	_, err = getJSON(tx, channelsBucket, nodePubKey, &records)
+	return records, err
+}
+
+func getChannelFailures(tx Tx, nodePubKey string) (map[string]ChannelFailure, error) {
+	failures := map[string]ChannelFailure{}
+	_, err := getJSON(tx, channelFailuresBucket, nodePubKey, &failures)
+
+	return failures, err
+}
+
+func getJSON(tx Tx, bucket string, key string, value interface{}) (bool, error) {
+	raw := tx.Get(bucket, key)
+
+	if raw == nil {
+		return false, nil
+	}
+
+	return true, json.Unmarshal(raw, value)
+}
+
+func putJSON(tx Tx, bucket string, key string, value interface{}) error {
+	raw, err := json.Marshal(value)
 
 	if err != nil {
-		logger.Error("Could not write database file: " + err.Error())
+		return err
 	}
+
+	return tx.Put(bucket, key, raw)
 }
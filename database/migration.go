@@ -0,0 +1,114 @@
+package database
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/google/logger"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"time"
+)
+
+const legacyMigratedKey = "legacyMigrated"
+
+// Format of the JSON files that were written by older versions of the bot
+type legacyContent struct {
+	ChannelsOpened map[string][]string `json:"channelsOpened"`
+	FaucetClaims   map[string]int64    `json:"faucetClaims"`
+}
+
+// Imports the JSON file of older versions once
+func (database *Database) migrateLegacyFile() error {
+	if database.LegacyFileName == "" {
+		return nil
+	}
+
+	migrated := false
+
+	err := database.backend.View(func(tx Tx) error {
+		migrated = tx.Get(metaBucket, legacyMigratedKey) != nil
+		return nil
+	})
+
+	if err != nil || migrated {
+		return err
+	}
+
+	raw, err := ioutil.ReadFile(database.LegacyFileName)
+
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	content, err := parseLegacyContent(raw)
+
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Migrating legacy database file: " + database.LegacyFileName)
+
+	// Legacy files do not contain the time at which a channel was opened
+	migratedAt := time.Now()
+
+	err = database.backend.Update(func(tx Tx) error {
+		for nodePubKey, currencies := range content.ChannelsOpened {
+			var records []ChannelRecord
+
+			for _, currency := range currencies {
+				records = append(records, ChannelRecord{
+					Currency:  currency,
+					State:     ChannelOpen,
+					OpenedAt:  migratedAt,
+					UpdatedAt: migratedAt,
+				})
+			}
+
+			if err := putJSON(tx, channelsBucket, nodePubKey, records); err != nil {
+				return err
+			}
+		}
+
+		for identifier, claimedAt := range content.FaucetClaims {
+			if err := putJSON(tx, faucetClaimsBucket, identifier, claimedAt); err != nil {
+				return err
+			}
+		}
+
+		return putJSON(tx, metaBucket, legacyMigratedKey, migratedAt.Unix())
+	})
+
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Migrated " + strconv.Itoa(len(content.ChannelsOpened)) + " nodes and " +
+		strconv.Itoa(len(content.FaucetClaims)) + " faucet claims from legacy database file")
+
+	return nil
+}
+
+// Legacy files can contain trailing garbage after the document because they were not truncated
+// when being rewritten, which is why only the first JSON value of the file is decoded
+func parseLegacyContent(raw []byte) (content legacyContent, err error) {
+	// Older versions created the file before anything was written to it
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return content, nil
+	}
+
+	if err = json.NewDecoder(bytes.NewReader(raw)).Decode(&content); err != nil {
+		return content, err
+	}
+
+	// The first versions of the file only contained the map of opened channels
+	if content.ChannelsOpened == nil && content.FaucetClaims == nil {
+		err = json.NewDecoder(bytes.NewReader(raw)).Decode(&content.ChannelsOpened)
+	}
+
+	return content, err
+}
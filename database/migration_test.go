@@ -0,0 +1,65 @@
+package database
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLegacyContent(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected legacyContent
+		valid    bool
+	}{
+		{"empty file", "", legacyContent{}, true},
+		{"whitespace", " \n", legacyContent{}, true},
+		{
+			"current format",
+			`{"channelsOpened":{"node":["BTC","LTC"]},"faucetClaims":{"0x01":1590000000}}`,
+			legacyContent{
+				ChannelsOpened: map[string][]string{"node": {"BTC", "LTC"}},
+				FaucetClaims:   map[string]int64{"0x01": 1590000000},
+			},
+			true,
+		},
+		{
+			"trailing garbage",
+			`{"channelsOpened":{"node":["BTC"]}}"node2":["LTC"]}}`,
+			legacyContent{
+				ChannelsOpened: map[string][]string{"node": {"BTC"}},
+			},
+			true,
+		},
+		{
+			"old format",
+			`{"node":["BTC","LTC"],"node2":["WETH"]}`,
+			legacyContent{
+				ChannelsOpened: map[string][]string{"node": {"BTC", "LTC"}, "node2": {"WETH"}},
+			},
+			true,
+		},
+		{
+			"old format with trailing garbage",
+			`{"node":["BTC"]}["LTC"]}`,
+			legacyContent{
+				ChannelsOpened: map[string][]string{"node": {"BTC"}},
+			},
+			true,
+		},
+		{"malformed", `{"channelsOpened":`, legacyContent{}, false},
+	}
+
+	for _, test := range tests {
+		content, err := parseLegacyContent([]byte(test.raw))
+
+		if (err == nil) != test.valid {
+			t.Errorf("%s: unexpected result: %v", test.name, err)
+			continue
+		}
+
+		if test.valid && !reflect.DeepEqual(content, test.expected) {
+			t.Errorf("%s: parseLegacyContent() = %+v; expected %+v", test.name, content, test.expected)
+		}
+	}
+}
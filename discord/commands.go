@@ -0,0 +1,116 @@
+package discord
+
+import (
+	"github.com/bwmarrin/discordgo"
+	"github.com/google/logger"
+	"sort"
+	"strings"
+)
+
+// Handles a command and returns the reply that should be sent to the author
+// The arguments are all words of the message after the name of the command
+type CommandHandler func(authorID string, args []string) (string, error)
+
+// Discord rejects messages that are longer
+const maxMessageLength = 2000
+
+type command struct {
+	usage       string
+	description string
+	handler     CommandHandler
+}
+
+// Registers a handler that is invoked when a message starts with the command prefix followed by the name
+func (discord *Discord) AddCommand(name string, usage string, description string, handler CommandHandler) {
+	discord.commandsLock.Lock()
+	defer discord.commandsLock.Unlock()
+
+	if discord.commands == nil {
+		discord.commands = map[string]*command{}
+	}
+
+	discord.commands[name] = &command{
+		usage:       usage,
+		description: description,
+		handler:     handler,
+	}
+}
+
+func (discord *Discord) handleMessage(session *discordgo.Session, message *discordgo.MessageCreate) {
+	if discord.CommandPrefix == "" || message.Author == nil || message.Author.Bot || !strings.HasPrefix(message.Content, discord.CommandPrefix) {
+		return
+	}
+
+	words := strings.Fields(strings.TrimPrefix(message.Content, discord.CommandPrefix))
+
+	if len(words) == 0 {
+		return
+	}
+
+	name := strings.ToLower(words[0])
+
+	var reply string
+	var err error
+
+	if name == "help" {
+		reply = discord.getHelp()
+	} else {
+		discord.commandsLock.RLock()
+		command, ok := discord.commands[name]
+		discord.commandsLock.RUnlock()
+
+		// Other bots in the same server might use the same prefix
+		if !ok {
+			return
+		}
+
+		logger.Info("Handling Discord command of " + message.Author.Username + ": " + message.Content)
+
+		reply, err = command.handler(message.Author.ID, words[1:])
+	}
+
+	if err != nil {
+		reply = "Could not execute `" + name + "`: " + err.Error()
+	}
+
+	reply = message.Author.Mention() + " " + reply
+
+	if len(reply) > maxMessageLength {
+		reply = reply[:maxMessageLength-3] + "..."
+	}
+
+	_, err = session.ChannelMessageSend(message.ChannelID, reply)
+
+	if err != nil {
+		logger.Warning("Could not reply to Discord command \"" + message.Content + "\": " + err.Error())
+	}
+}
+
+func (discord *Discord) getHelp() string {
+	discord.commandsLock.RLock()
+	defer discord.commandsLock.RUnlock()
+
+	var names []string
+
+	for name := range discord.commands {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	help := "Available commands:"
+
+	for _, name := range names {
+		command := discord.commands[name]
+
+		usage := discord.CommandPrefix + name
+
+		if command.usage != "" {
+			usage += " " + command.usage
+		}
+
+		help += "\n`" + usage + "`: " + command.description
+	}
+
+	return help
+}
@@ -3,8 +3,10 @@ package discord
 import (
 	"errors"
 	"fmt"
+	"github.com/ExchangeUnion/xud-simnet-bot/metrics"
 	"github.com/bwmarrin/discordgo"
 	"github.com/google/logger"
+	"sync"
 )
 
 type Discord struct {
@@ -12,8 +14,13 @@ type Discord struct {
 	Channel string `long:"discord.channel" description:"Name of the channel to which messages should be sent"`
 	Prefix  string `long:"discord.prefix" description:"Prefix for every message"`
 
+	CommandPrefix string `long:"discord.commandprefix" default:"!" description:"Prefix of messages that should be handled as commands"`
+
 	api       *discordgo.Session
 	channelID string
+
+	commands     map[string]*command
+	commandsLock sync.RWMutex
 }
 
 func (discord *Discord) getChannelId() error {
@@ -52,6 +59,8 @@ func (discord *Discord) Init() (err error) {
 		return err
 	}
 
+	discord.api.AddHandler(discord.handleMessage)
+
 	err = discord.api.Open()
 
 	if err != nil {
@@ -61,6 +70,10 @@ func (discord *Discord) Init() (err error) {
 	return discord.getChannelId()
 }
 
+func (discord *Discord) Close() error {
+	return discord.api.Close()
+}
+
 func (discord *Discord) SendMessage(message string) error {
 	if discord.Prefix != "" {
 		message = discord.Prefix + ": " + message
@@ -69,6 +82,7 @@ func (discord *Discord) SendMessage(message string) error {
 	_, err := discord.api.ChannelMessageSend(discord.channelID, message)
 
 	if err != nil {
+		metrics.DiscordSendFailures.Inc()
 		logger.Warning("Could not send \"" + message + "\" to Discord: " + fmt.Sprint(err))
 	}
 
@@ -0,0 +1,74 @@
+package faucet
+
+import (
+	"errors"
+	"math/big"
+)
+
+// Currency that is sent by the faucet
+type Asset struct {
+	// Symbol of the currency
+	Currency string
+	// Address of the ERC20 token contract; has to be set for every currency other than Ether
+	TokenAddress string
+	// Amount in whole coins that is sent per request
+	Amount float64
+	// Balance of the faucet wallet below which a warning is sent to Discord
+	MinBalance float64
+
+	// Number of decimals of the token; read from the token contract or XUD if not set
+	Decimals uint8
+	// Gas limit of the transfers; estimated if not set
+	GasLimit uint64
+}
+
+// Symbol of the only currency that is sent without token contract
+const EtherCurrency = "ETH"
+
+// Assets of other currencies without token address are invalid rather than Ether so that they never pay out Ether
+func (asset *Asset) IsEther() bool {
+	return asset.TokenAddress == "" && asset.Currency == EtherCurrency
+}
+
+func (asset *Asset) getDecimals() uint8 {
+	if asset.IsEther() {
+		return etherDecimals
+	}
+
+	return asset.Decimals
+}
+
+// Resolves the decimals of tokens whose decimals are not configured
+// The token contract is asked first and XUD, whose decimal places are passed as fallback, second
+func (asset *Asset) ResolveDecimals(eth *Ethereum, xudDecimalPlaces uint32) error {
+	if !asset.IsValid() {
+		return errors.New("token address is not set")
+	}
+
+	if asset.IsEther() || asset.Decimals != 0 {
+		return nil
+	}
+
+	decimals, err := eth.GetTokenDecimals(asset.TokenAddress)
+
+	if err == nil {
+		asset.Decimals = decimals
+		return nil
+	}
+
+	if xudDecimalPlaces != 0 {
+		asset.Decimals = uint8(xudDecimalPlaces)
+		return nil
+	}
+
+	return errors.New("could not get decimals of token: " + err.Error())
+}
+
+func (asset *Asset) IsValid() bool {
+	return asset.IsEther() || asset.TokenAddress != ""
+}
+
+// Returns the amount that is sent per request in the smallest unit of the asset
+func (asset *Asset) GetBaseAmount() (*big.Int, error) {
+	return toBaseUnits(asset.Amount, asset.getDecimals())
+}
@@ -0,0 +1,71 @@
+package faucet
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"golang.org/x/crypto/sha3"
+	"math/big"
+)
+
+// Returns the balance of the faucet wallet in the asset in whole coins
+func (eth *Ethereum) GetAssetBalance(asset Asset) (float64, error) {
+	var balance *big.Int
+	var err error
+
+	if !asset.IsValid() {
+		return 0, errors.New("token address is not set")
+	}
+
+	if asset.IsEther() {
+		balance, err = eth.GetEtherBalance()
+	} else {
+		balance, err = eth.GetTokenBalance(asset.TokenAddress)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return fromBaseUnits(balance, asset.getDecimals()), nil
+}
+
+// Checks whether a contract is deployed at the address
+func (eth *Ethereum) IsContract(address string) (bool, error) {
+	code, err := eth.client.CodeAt(eth.ctx, common.HexToAddress(address), nil)
+	return len(code) != 0, err
+}
+
+func (eth *Ethereum) GetEtherBalance() (*big.Int, error) {
+	return eth.client.BalanceAt(eth.ctx, eth.account.Address, nil)
+}
+
+// Queries the ERC20 "balanceOf" function of the token contract for the address of the faucet
+func (eth *Ethereum) GetTokenBalance(token string) (*big.Int, error) {
+	tokenAddress := common.HexToAddress(token)
+
+	var data []byte
+
+	data = append(data, getFunctionSelector("balanceOf(address)")...)
+	data = append(data, common.LeftPadBytes(eth.account.Address.Bytes(), 32)...)
+
+	result, err := eth.client.CallContract(eth.ctx, ethereum.CallMsg{
+		From: eth.account.Address,
+		To:   &tokenAddress,
+		Data: data,
+	}, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(result), nil
+}
+
+// Returns the first four bytes of the Keccak256 hash of the function signature
+func getFunctionSelector(signature string) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	_, _ = hash.Write([]byte(signature))
+
+	return hash.Sum(nil)[:4]
+}
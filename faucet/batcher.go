@@ -0,0 +1,321 @@
+package faucet
+
+import (
+	"context"
+	"errors"
+	"github.com/ExchangeUnion/xud-simnet-bot/database"
+	"github.com/ExchangeUnion/xud-simnet-bot/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/logger"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Collects the payouts of multiple requests and sends them in a single transaction to the disperse contract
+type payoutBatcher struct {
+	window   time.Duration
+	contract common.Address
+	// Maximal number of payouts per transaction; 0 disables the limit
+	maxPayouts int
+
+	eth      *Ethereum
+	requests chan batchRequest
+}
+
+type batchRequest struct {
+	payouts []Payout
+	result  chan batchResult
+}
+
+type batchResult struct {
+	transaction *Transaction
+	err         error
+}
+
+func newPayoutBatcher(window time.Duration, contract common.Address, maxPayouts int, eth *Ethereum) *payoutBatcher {
+	return &payoutBatcher{
+		window:     window,
+		contract:   contract,
+		maxPayouts: maxPayouts,
+		eth:        eth,
+		requests:   make(chan batchRequest),
+	}
+}
+
+// Blocks until the batch that contains the payouts was sent
+func (batcher *payoutBatcher) send(ctx context.Context, payouts []Payout) (*Transaction, error) {
+	if batcher.window == 0 {
+		return batcher.eth.SendPayouts(batcher.contract, payouts)
+	}
+
+	request := batchRequest{
+		payouts: payouts,
+		result:  make(chan batchResult, 1),
+	}
+
+	select {
+	case batcher.requests <- request:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	result := <-request.result
+	return result.transaction, result.err
+}
+
+// Sends the collected payouts once the window after the first request of a batch elapsed
+func (batcher *payoutBatcher) run(ctx context.Context) {
+	for {
+		var batch []batchRequest
+
+		select {
+		case <-ctx.Done():
+			return
+
+		case request := <-batcher.requests:
+			batch = append(batch, request)
+		}
+
+		timer := time.NewTimer(batcher.window)
+
+	collect:
+		for {
+			select {
+			case request := <-batcher.requests:
+				batch = append(batch, request)
+
+			case <-timer.C:
+				break collect
+
+			case <-ctx.Done():
+				timer.Stop()
+				break collect
+			}
+		}
+
+		batcher.sendBatch(batch)
+	}
+}
+
+func (batcher *payoutBatcher) sendBatch(batch []batchRequest) {
+	for _, chunk := range splitBatch(batch, batcher.maxPayouts) {
+		batcher.sendChunk(chunk)
+	}
+}
+
+// Sends the requests of a failed chunk one by one so that a single failing payout does not fail all requests
+func (batcher *payoutBatcher) sendChunk(chunk []batchRequest) {
+	var payouts []Payout
+
+	for _, request := range chunk {
+		payouts = append(payouts, request.payouts...)
+	}
+
+	transaction, err := batcher.eth.SendPayouts(batcher.contract, payouts)
+
+	if err == nil || len(chunk) == 1 {
+		for _, request := range chunk {
+			request.result <- batchResult{
+				transaction: transaction,
+				err:         err,
+			}
+		}
+
+		return
+	}
+
+	logger.Warning("Could not send batch of " + strconv.Itoa(len(chunk)) + " faucet requests; sending them one by one: " + err.Error())
+
+	for _, request := range chunk {
+		transaction, err := batcher.eth.SendPayouts(batcher.contract, request.payouts)
+
+		request.result <- batchResult{
+			transaction: transaction,
+			err:         err,
+		}
+	}
+}
+
+// Groups the requests into chunks with at most the maximal number of payouts
+// The payouts of a single request are never split so requests with more payouts than that get a chunk of their own
+func splitBatch(batch []batchRequest, maxPayouts int) [][]batchRequest {
+	var chunks [][]batchRequest
+	var chunk []batchRequest
+	payouts := 0
+
+	for _, request := range batch {
+		if len(chunk) > 0 && maxPayouts > 0 && payouts+len(request.payouts) > maxPayouts {
+			chunks = append(chunks, chunk)
+
+			chunk = nil
+			payouts = 0
+		}
+
+		chunk = append(chunk, request)
+		payouts += len(request.payouts)
+	}
+
+	if len(chunk) > 0 {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// Uses the configured disperse contract or the one that was deployed previously and deploys a new one otherwise
+func (faucet *Faucet) initBatching(database *database.Database) error {
+	address := faucet.DisperseAddress
+
+	if address == "" {
+		stored, err := database.GetDisperseAddress()
+
+		if err != nil {
+			return err
+		}
+
+		// The chain could have been reset since the contract was deployed
+		if stored != "" {
+			isContract, err := faucet.eth.IsContract(stored)
+
+			if err != nil {
+				return err
+			}
+
+			if isContract {
+				address = stored
+			} else {
+				logger.Warning("Disperse contract at " + stored + " does not exist anymore")
+			}
+		}
+	}
+
+	if address == "" {
+		logger.Info("Deploying disperse contract")
+
+		deployed, err := faucet.eth.DeployDisperse()
+
+		if err != nil {
+			return errors.New("could not deploy disperse contract: " + err.Error())
+		}
+
+		address = deployed.String()
+
+		if err := database.SetDisperseAddress(address); err != nil {
+			return err
+		}
+	}
+
+	if !common.IsHexAddress(address) {
+		return errors.New("invalid disperse contract address: " + address)
+	}
+
+	isContract, err := faucet.eth.IsContract(address)
+
+	if err != nil {
+		return err
+	}
+
+	if !isContract {
+		return errors.New("no contract is deployed at " + address)
+	}
+
+	logger.Info("Sending faucet payouts through disperse contract: " + address)
+
+	faucet.batcher = newPayoutBatcher(
+		time.Duration(faucet.BatchWindow)*time.Second,
+		common.HexToAddress(address),
+		faucet.MaxBatchPayouts,
+		faucet.eth,
+	)
+	faucet.workers.Add(1)
+
+	go func() {
+		defer faucet.workers.Done()
+		faucet.batcher.run(faucet.ctx)
+	}()
+
+	return nil
+}
+
+// Sends all assets in a single transaction so either all transfers succeed or none
+func (faucet *Faucet) sendBatchedTokens(address string) (response faucetResponse, err error) {
+	response.TokensSent = map[string]string{}
+
+	// An invalid address would make the whole batch of other requests fail
+	if !common.IsHexAddress(address) {
+		return response, errors.New("invalid address: " + address)
+	}
+
+	var assets []Asset
+	var payouts []Payout
+	var firstError error
+
+	for _, asset := range faucet.getAssets() {
+		// A payout without token address would be sent as Ether by the disperse contract
+		if !asset.IsValid() {
+			invalidError := errors.New("token address is not set")
+			logger.Warning("Could not send " + asset.Currency + " to " + address + ": " + invalidError.Error())
+
+			if firstError == nil {
+				firstError = invalidError
+			}
+
+			response.Transfers = append(response.Transfers, transferResult{
+				Currency: asset.Currency,
+				Amount:   "0",
+				Status:   transferError,
+				Error:    invalidError.Error(),
+			})
+			continue
+		}
+
+		amount, err := asset.GetBaseAmount()
+
+		if err != nil {
+			return response, errors.New("could not get amount of " + asset.Currency + ": " + err.Error())
+		}
+
+		assets = append(assets, asset)
+		payouts = append(payouts, Payout{
+			TokenAddress: asset.TokenAddress,
+			Recipient:    address,
+			Amount:       amount,
+		})
+	}
+
+	if len(payouts) == 0 {
+		return response, firstError
+	}
+
+	transaction, err := faucet.batcher.send(faucet.ctx, payouts)
+
+	if err != nil {
+		return response, err
+	}
+
+	var currencies []string
+
+	for i, asset := range assets {
+		amount := payouts[i].Amount.String()
+
+		response.TokensSent[asset.Currency] = amount
+		response.Transfers = append(response.Transfers, transferResult{
+			Currency:        asset.Currency,
+			Amount:          amount,
+			TransactionHash: transaction.Hash().String(),
+			Status:          transferSuccess,
+		})
+
+		metrics.TokensDispensed.WithLabelValues(asset.Currency).Add(asset.Amount)
+		currencies = append(currencies, asset.Currency)
+	}
+
+	faucet.workers.Add(1)
+	go faucet.reportTransactions(address, []sentTransaction{{
+		currency:    strings.Join(currencies, ", "),
+		transaction: transaction,
+	}})
+
+	return response, nil
+}
@@ -0,0 +1,59 @@
+package faucet
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitBatch(t *testing.T) {
+	tests := []struct {
+		name string
+		// Number of payouts of every request
+		requests   []int
+		maxPayouts int
+		// Number of requests in every chunk
+		expected []int
+	}{
+		{"no limit", []int{2, 2, 2}, 0, []int{3}},
+		{"fits into one chunk", []int{2, 2}, 4, []int{2}},
+		{"split at the limit", []int{2, 2, 2}, 4, []int{2, 1}},
+		{"request above the limit", []int{1, 5, 1}, 4, []int{1, 1, 1}},
+		{"single payouts", []int{1, 1, 1, 1, 1}, 2, []int{2, 2, 1}},
+		{"empty", nil, 4, nil},
+	}
+
+	for _, test := range tests {
+		var batch []batchRequest
+
+		for _, payouts := range test.requests {
+			batch = append(batch, batchRequest{payouts: make([]Payout, payouts)})
+		}
+
+		var chunkSizes []int
+
+		for _, chunk := range splitBatch(batch, test.maxPayouts) {
+			chunkSizes = append(chunkSizes, len(chunk))
+		}
+
+		if !reflect.DeepEqual(chunkSizes, test.expected) {
+			t.Errorf("%s: chunks of %v requests; expected %v", test.name, chunkSizes, test.expected)
+		}
+	}
+}
+
+func TestBatchedTokensRejectInvalidAssets(t *testing.T) {
+	faucet := Faucet{
+		assets:  []Asset{{Currency: "DAI", Amount: 1, Decimals: 18}},
+		batcher: &payoutBatcher{},
+	}
+
+	response, err := faucet.sendBatchedTokens(testAddress)
+
+	if err == nil {
+		t.Fatal("asset without token address was sent")
+	}
+
+	if len(response.TokensSent) != 0 || len(response.Transfers) != 1 || response.Transfers[0].Status != transferError {
+		t.Errorf("unexpected response: %+v", response)
+	}
+}
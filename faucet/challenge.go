@@ -0,0 +1,283 @@
+package faucet
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/bits"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Verifies captcha tokens that were solved by clients
+type CaptchaVerifier interface {
+	Verify(token string, remoteIP string) error
+}
+
+type challengeResponse struct {
+	// Empty if no proof of work is required
+	Challenge string `json:"challenge,omitempty"`
+	// Number of leading zero bits the hash of the solution must have
+	Difficulty int        `json:"difficulty"`
+	Captcha    bool       `json:"captcha"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+}
+
+var errInvalidChallenge = errors.New("invalid or expired challenge")
+
+// Issues hashcash style proof of work challenges and verifies their solutions and captcha tokens
+// A solution is a nonce for which the SHA256 hash of "<challenge>:<address>:<nonce>" has enough leading zero bits
+//
+// Challenges are signed with a key that is generated on startup so that issuing them requires no state;
+// only challenges that were solved are stored until they expire to prevent that a solution is used twice
+type challenger struct {
+	difficulty int
+	timeout    time.Duration
+
+	// Nil if no captcha is required
+	verifier CaptchaVerifier
+
+	key []byte
+
+	// Map between the challenges that were solved and the time at which they expire
+	solved     map[string]time.Time
+	solvedLock sync.Mutex
+}
+
+func newChallenger(difficulty int, timeout time.Duration, verifier CaptchaVerifier) (*challenger, error) {
+	key := make([]byte, 32)
+
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	return &challenger{
+		difficulty: difficulty,
+		timeout:    timeout,
+		verifier:   verifier,
+		key:        key,
+		solved:     map[string]time.Time{},
+	}, nil
+}
+
+func (challenger *challenger) enabled() bool {
+	return challenger.difficulty > 0 || challenger.verifier != nil
+}
+
+// Challenges have the format "<expiry as UNIX timestamp>.<random salt>.<signature>"
+func (challenger *challenger) issue() (response challengeResponse, err error) {
+	response.Difficulty = challenger.difficulty
+	response.Captcha = challenger.verifier != nil
+
+	if challenger.difficulty == 0 {
+		return response, nil
+	}
+
+	salt := make([]byte, 16)
+
+	if _, err := rand.Read(salt); err != nil {
+		return response, err
+	}
+
+	expiresAt := time.Now().Add(challenger.timeout).Truncate(time.Second)
+	payload := strconv.FormatInt(expiresAt.Unix(), 10) + "." + hex.EncodeToString(salt)
+
+	response.Challenge = payload + "." + challenger.sign(payload)
+	response.ExpiresAt = &expiresAt
+
+	return response, nil
+}
+
+// Every challenge can be solved only once
+func (challenger *challenger) verify(request faucetRequest, remoteIP string) error {
+	if challenger.difficulty > 0 {
+		expiresAt, err := challenger.checkSignature(request.Challenge)
+
+		if err != nil {
+			return err
+		}
+
+		hash := sha256.Sum256([]byte(request.Challenge + ":" + request.Address + ":" + request.Nonce))
+
+		if leadingZeroBits(hash[:]) < challenger.difficulty {
+			return errors.New("invalid proof of work")
+		}
+
+		if !challenger.markSolved(request.Challenge, expiresAt) {
+			return errors.New("challenge was solved already")
+		}
+	}
+
+	if challenger.verifier != nil {
+		if request.CaptchaToken == "" {
+			return errors.New("no captcha token was provided")
+		}
+
+		if err := challenger.verifier.Verify(request.CaptchaToken, remoteIP); err != nil {
+			return errors.New("invalid captcha: " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Returns the time at which the challenge expires if it was issued by this challenger and did not expire yet
+func (challenger *challenger) checkSignature(challenge string) (time.Time, error) {
+	parts := strings.Split(challenge, ".")
+
+	if len(parts) != 3 {
+		return time.Time{}, errInvalidChallenge
+	}
+
+	payload := parts[0] + "." + parts[1]
+
+	if !hmac.Equal([]byte(parts[2]), []byte(challenger.sign(payload))) {
+		return time.Time{}, errInvalidChallenge
+	}
+
+	timestamp, err := strconv.ParseInt(parts[0], 10, 64)
+
+	if err != nil {
+		return time.Time{}, errInvalidChallenge
+	}
+
+	expiresAt := time.Unix(timestamp, 0)
+
+	if time.Now().After(expiresAt) {
+		return time.Time{}, errInvalidChallenge
+	}
+
+	return expiresAt, nil
+}
+
+// Returns false if the challenge was solved before
+func (challenger *challenger) markSolved(challenge string, expiresAt time.Time) bool {
+	challenger.solvedLock.Lock()
+	defer challenger.solvedLock.Unlock()
+
+	now := time.Now()
+
+	for solved, solvedExpiresAt := range challenger.solved {
+		if now.After(solvedExpiresAt) {
+			delete(challenger.solved, solved)
+		}
+	}
+
+	if _, ok := challenger.solved[challenge]; ok {
+		return false
+	}
+
+	challenger.solved[challenge] = expiresAt
+	return true
+}
+
+func (challenger *challenger) sign(payload string) string {
+	mac := hmac.New(sha256.New, challenger.key)
+	mac.Write([]byte(payload))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func leadingZeroBits(hash []byte) int {
+	zeros := 0
+
+	for _, value := range hash {
+		zeros += bits.LeadingZeros8(value)
+
+		if value != 0 {
+			break
+		}
+	}
+
+	return zeros
+}
+
+// Accepts every token that is not empty; meant for local testing
+type stubVerifier struct{}
+
+func (stubVerifier) Verify(token string, _ string) error {
+	if token == "" {
+		return errors.New("empty token")
+	}
+
+	return nil
+}
+
+// Verifies tokens with the siteverify API that is implemented by reCAPTCHA and hCaptcha
+type siteVerifyVerifier struct {
+	url    string
+	secret string
+
+	client *http.Client
+}
+
+type siteVerifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+func newSiteVerifyVerifier(url string, secret string) *siteVerifyVerifier {
+	return &siteVerifyVerifier{
+		url:    url,
+		secret: secret,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (verifier *siteVerifyVerifier) Verify(token string, remoteIP string) error {
+	response, err := verifier.client.PostForm(verifier.url, url.Values{
+		"secret":   {verifier.secret},
+		"response": {token},
+		"remoteip": {remoteIP},
+	})
+
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	var result siteVerifyResponse
+
+	if err := json.NewDecoder(response.Body).Decode(&result); err != nil {
+		return errors.New("could not parse response of captcha verification: " + err.Error())
+	}
+
+	if !result.Success {
+		if len(result.ErrorCodes) > 0 {
+			return errors.New(result.ErrorCodes[0])
+		}
+
+		return errors.New("verification failed")
+	}
+
+	return nil
+}
+
+// Returns nil if no captcha is required
+func (faucet *Faucet) getCaptchaVerifier() (CaptchaVerifier, error) {
+	switch faucet.Captcha {
+	case "":
+		return nil, nil
+
+	case "stub":
+		return stubVerifier{}, nil
+
+	case "siteverify":
+		if faucet.CaptchaURL == "" || faucet.CaptchaSecret == "" {
+			return nil, errors.New("captcha URL and secret have to be set")
+		}
+
+		return newSiteVerifyVerifier(faucet.CaptchaURL, faucet.CaptchaSecret), nil
+
+	default:
+		return nil, errors.New("unknown captcha verifier: " + faucet.Captcha)
+	}
+}
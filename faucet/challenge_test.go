@@ -0,0 +1,206 @@
+package faucet
+
+import (
+	"crypto/sha256"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testAddress = "0x000000000000000000000000000000000000dEaD"
+
+func solveChallenge(challenge string, address string, difficulty int) string {
+	for i := 0; ; i++ {
+		nonce := strconv.Itoa(i)
+		hash := sha256.Sum256([]byte(challenge + ":" + address + ":" + nonce))
+
+		if leadingZeroBits(hash[:]) >= difficulty {
+			return nonce
+		}
+	}
+}
+
+func newTestChallenger(t *testing.T, difficulty int, timeout time.Duration, verifier CaptchaVerifier) *challenger {
+	challenger, err := newChallenger(difficulty, timeout, verifier)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return challenger
+}
+
+func TestLeadingZeroBits(t *testing.T) {
+	tests := []struct {
+		hash     []byte
+		expected int
+	}{
+		{[]byte{0xff}, 0},
+		{[]byte{0x01}, 7},
+		{[]byte{0x00, 0x80}, 8},
+		{[]byte{0x00, 0x10}, 11},
+		{[]byte{0x00, 0x00}, 16},
+	}
+
+	for _, test := range tests {
+		if zeros := leadingZeroBits(test.hash); zeros != test.expected {
+			t.Errorf("leadingZeroBits(%x) = %d; expected %d", test.hash, zeros, test.expected)
+		}
+	}
+}
+
+func TestChallengeVerification(t *testing.T) {
+	const difficulty = 8
+
+	tests := []struct {
+		name string
+		// Modifies the request with the correct solution
+		modify func(request *faucetRequest)
+		valid  bool
+	}{
+		{"valid solution", func(request *faucetRequest) {}, true},
+		{"wrong nonce", func(request *faucetRequest) {
+			for i := 0; ; i++ {
+				request.Nonce = strconv.Itoa(i)
+				hash := sha256.Sum256([]byte(request.Challenge + ":" + request.Address + ":" + request.Nonce))
+
+				if leadingZeroBits(hash[:]) < difficulty {
+					return
+				}
+			}
+		}, false},
+		{"solution for other address", func(request *faucetRequest) {
+			request.Nonce = solveChallenge(request.Challenge, "0x0000000000000000000000000000000000000001", difficulty)
+			hash := sha256.Sum256([]byte(request.Challenge + ":" + request.Address + ":" + request.Nonce))
+
+			// The solution is valid for both addresses with a probability of 1/256
+			if leadingZeroBits(hash[:]) >= difficulty {
+				request.Nonce = ""
+				request.Challenge = ""
+			}
+		}, false},
+		{"tampered expiry", func(request *faucetRequest) {
+			parts := strings.Split(request.Challenge, ".")
+			parts[0] = "9999999999"
+
+			request.Challenge = strings.Join(parts, ".")
+			request.Nonce = solveChallenge(request.Challenge, request.Address, difficulty)
+		}, false},
+		{"malformed challenge", func(request *faucetRequest) {
+			request.Challenge = "challenge"
+			request.Nonce = solveChallenge(request.Challenge, request.Address, difficulty)
+		}, false},
+		{"missing challenge", func(request *faucetRequest) {
+			request.Challenge = ""
+		}, false},
+	}
+
+	for _, test := range tests {
+		challenger := newTestChallenger(t, difficulty, time.Minute, nil)
+		issued, err := challenger.issue()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		request := faucetRequest{
+			Address:   testAddress,
+			Challenge: issued.Challenge,
+		}
+		request.Nonce = solveChallenge(request.Challenge, request.Address, difficulty)
+
+		test.modify(&request)
+
+		if err := challenger.verify(request, ""); (err == nil) != test.valid {
+			t.Errorf("%s: unexpected result: %v", test.name, err)
+		}
+	}
+}
+
+func TestChallengeReuse(t *testing.T) {
+	challenger := newTestChallenger(t, 4, time.Minute, nil)
+	issued, err := challenger.issue()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := faucetRequest{
+		Address:   testAddress,
+		Challenge: issued.Challenge,
+		Nonce:     solveChallenge(issued.Challenge, testAddress, 4),
+	}
+
+	if err := challenger.verify(request, ""); err != nil {
+		t.Fatalf("first solution was rejected: %v", err)
+	}
+
+	if err := challenger.verify(request, ""); err == nil {
+		t.Fatal("solution was accepted twice")
+	}
+}
+
+func TestChallengeExpiry(t *testing.T) {
+	challenger := newTestChallenger(t, 4, -time.Second, nil)
+	issued, err := challenger.issue()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := faucetRequest{
+		Address:   testAddress,
+		Challenge: issued.Challenge,
+		Nonce:     solveChallenge(issued.Challenge, testAddress, 4),
+	}
+
+	if err := challenger.verify(request, ""); err != errInvalidChallenge {
+		t.Fatalf("expired challenge was not rejected: %v", err)
+	}
+}
+
+func TestChallengeFromOtherChallenger(t *testing.T) {
+	issuer := newTestChallenger(t, 4, time.Minute, nil)
+	verifier := newTestChallenger(t, 4, time.Minute, nil)
+
+	issued, err := issuer.issue()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := faucetRequest{
+		Address:   testAddress,
+		Challenge: issued.Challenge,
+		Nonce:     solveChallenge(issued.Challenge, testAddress, 4),
+	}
+
+	if err := verifier.verify(request, ""); err != errInvalidChallenge {
+		t.Fatalf("challenge signed with a different key was not rejected: %v", err)
+	}
+}
+
+func TestStubCaptcha(t *testing.T) {
+	tests := []struct {
+		token string
+		valid bool
+	}{
+		{"token", true},
+		{"", false},
+	}
+
+	challenger := newTestChallenger(t, 0, time.Minute, stubVerifier{})
+
+	if issued, err := challenger.issue(); err != nil || issued.Challenge != "" || !issued.Captcha {
+		t.Fatalf("unexpected challenge without proof of work: %+v %v", issued, err)
+	}
+
+	for _, test := range tests {
+		err := challenger.verify(faucetRequest{Address: testAddress, CaptchaToken: test.token}, "")
+
+		if (err == nil) != test.valid {
+			t.Errorf("token %q: unexpected result: %v", test.token, err)
+		}
+	}
+}
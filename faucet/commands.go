@@ -0,0 +1,137 @@
+package faucet
+
+import (
+	"errors"
+	"github.com/ExchangeUnion/xud-simnet-bot/metrics"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/logger"
+	"math"
+	"strconv"
+)
+
+func (faucet *Faucet) registerCommands() {
+	usage := "<address>"
+
+	if faucet.challenger.difficulty > 0 {
+		usage += " <challenge> <nonce>"
+	}
+
+	if faucet.challenger.verifier != nil {
+		usage += " <captcha token>"
+	}
+
+	faucet.discord.AddCommand("faucet", usage, "Sends test tokens to an Ethereum address", faucet.handleFaucetCommand)
+
+	if faucet.challenger.difficulty > 0 {
+		faucet.discord.AddCommand("challenge", "", "Returns a proof of work challenge for the faucet", faucet.handleChallengeCommand)
+	}
+}
+
+func (faucet *Faucet) handleChallengeCommand(_ string, _ []string) (string, error) {
+	challenge, err := faucet.challenger.issue()
+
+	if err != nil {
+		logger.Warning("Could not issue faucet challenge: " + err.Error())
+		return "", errors.New("could not issue challenge")
+	}
+
+	return "Find a nonce for which the SHA256 hash of `" + challenge.Challenge + ":<address>:<nonce>` has " +
+		strconv.Itoa(challenge.Difficulty) + " leading zero bits and send `!faucet <address> " + challenge.Challenge +
+		" <nonce>`", nil
+}
+
+// Parses the arguments of the faucet command which contain the solution of the challenge if it is enabled
+func (faucet *Faucet) parseFaucetCommand(args []string) (faucetRequest, error) {
+	expected := 1
+
+	if faucet.challenger.difficulty > 0 {
+		expected += 2
+	}
+
+	if faucet.challenger.verifier != nil {
+		expected++
+	}
+
+	if len(args) != expected {
+		return faucetRequest{}, errors.New("expected " + strconv.Itoa(expected) + " arguments but got " + strconv.Itoa(len(args)))
+	}
+
+	request := faucetRequest{Address: args[0]}
+
+	if faucet.challenger.difficulty > 0 {
+		request.Challenge = args[1]
+		request.Nonce = args[2]
+	}
+
+	if faucet.challenger.verifier != nil {
+		request.CaptchaToken = args[expected-1]
+	}
+
+	return request, nil
+}
+
+func (faucet *Faucet) handleFaucetCommand(authorID string, args []string) (string, error) {
+	request, err := faucet.parseFaucetCommand(args)
+
+	if err != nil {
+		return "", err
+	}
+
+	if faucet.IsPaused() {
+		metrics.FaucetRequests.WithLabelValues(metrics.FaucetPaused).Inc()
+		return "", errors.New("faucet is paused")
+	}
+
+	address := request.Address
+
+	if !common.IsHexAddress(address) {
+		return "", errors.New("invalid address: " + address)
+	}
+
+	if faucet.challenger.enabled() {
+		if err := faucet.challenger.verify(request, ""); err != nil {
+			metrics.FaucetRequests.WithLabelValues(metrics.FaucetChallengeFailed).Inc()
+			return "", errors.New("challenge failed: " + err.Error())
+		}
+	}
+
+	address = common.HexToAddress(address).Hex()
+
+	client := discordIdentifier(authorID)
+
+	retryAfter, err := faucet.limiter.claim(address, client)
+
+	if err != nil {
+		logger.Error("Could not check faucet rate limit: " + err.Error())
+		return "", errors.New("could not check rate limit")
+	}
+
+	if retryAfter > 0 {
+		metrics.FaucetRequests.WithLabelValues(metrics.FaucetRateLimited).Inc()
+
+		return "", errors.New("tokens were requested too recently; try again in " +
+			strconv.FormatInt(int64(math.Ceil(retryAfter.Seconds())), 10) + " seconds")
+	}
+
+	queued, err := faucet.enqueue(address, client, true)
+
+	if err != nil {
+		if err := faucet.limiter.release(address, client); err != nil {
+			logger.Warning("Could not release faucet claim: " + err.Error())
+		}
+
+		if err == errQueueFull {
+			metrics.FaucetRequests.WithLabelValues(metrics.FaucetQueueFull).Inc()
+			return "", err
+		}
+
+		metrics.FaucetRequests.WithLabelValues(metrics.FaucetFailed).Inc()
+		logger.Error("Could not queue faucet request: " + err.Error())
+
+		return "", errors.New("could not queue request")
+	}
+
+	logger.Info("Queued faucet request " + queued.ID + " for " + address + " on Discord request")
+
+	return "Queued request `" + queued.ID + "` for `" + address + "`; the transactions are posted once they were sent", nil
+}
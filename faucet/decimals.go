@@ -0,0 +1,88 @@
+package faucet
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Ether and most ERC20 tokens have 18 decimals
+const etherDecimals = 18
+
+// Queries the ERC20 "decimals" function of the token contract; the result is cached because it cannot change
+func (eth *Ethereum) GetTokenDecimals(token string) (uint8, error) {
+	tokenAddress := common.HexToAddress(token)
+
+	eth.decimalsLock.Lock()
+	defer eth.decimalsLock.Unlock()
+
+	if decimals, ok := eth.decimals[tokenAddress]; ok {
+		return decimals, nil
+	}
+
+	result, err := eth.client.CallContract(eth.ctx, ethereum.CallMsg{
+		To:   &tokenAddress,
+		Data: getFunctionSelector("decimals()"),
+	}, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	value := new(big.Int).SetBytes(result)
+
+	if len(result) == 0 || !value.IsUint64() || value.Uint64() > 255 {
+		return 0, errors.New("token does not implement decimals()")
+	}
+
+	if eth.decimals == nil {
+		eth.decimals = map[common.Address]uint8{}
+	}
+
+	decimals := uint8(value.Uint64())
+	eth.decimals[tokenAddress] = decimals
+
+	return decimals, nil
+}
+
+// Converts an amount in whole coins into the smallest unit with the given number of decimals
+// The shortest decimal representation of the amount is used so that e.g. 0.1 results in exactly 10^(decimals - 1)
+func toBaseUnits(amount float64, decimals uint8) (*big.Int, error) {
+	if amount < 0 {
+		return nil, errors.New("amount must not be negative")
+	}
+
+	formatted := strconv.FormatFloat(amount, 'f', -1, 64)
+	parts := strings.SplitN(formatted, ".", 2)
+
+	fraction := ""
+
+	if len(parts) == 2 {
+		fraction = parts[1]
+	}
+
+	if len(fraction) > int(decimals) {
+		return nil, errors.New("amount " + formatted + " has more than " + strconv.Itoa(int(decimals)) + " decimal places")
+	}
+
+	digits := parts[0] + fraction + strings.Repeat("0", int(decimals)-len(fraction))
+
+	value, ok := new(big.Int).SetString(digits, 10)
+
+	if !ok {
+		return nil, errors.New("could not parse amount: " + formatted)
+	}
+
+	return value, nil
+}
+
+// Converts an amount in the smallest unit with the given number of decimals into whole coins
+func fromBaseUnits(value *big.Int, decimals uint8) float64 {
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	coins, _ := new(big.Rat).SetFrac(value, factor).Float64()
+
+	return coins
+}
@@ -0,0 +1,72 @@
+package faucet
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestToBaseUnits(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		decimals uint8
+		expected string
+		valid    bool
+	}{
+		{0, 18, "0", true},
+		{1, 18, "1000000000000000000", true},
+		{0.1, 18, "100000000000000000", true},
+		{0.3, 18, "300000000000000000", true},
+		{1.23456789, 8, "123456789", true},
+		{100, 6, "100000000", true},
+		{0.000001, 6, "1", true},
+		{1234567.5, 2, "123456750", true},
+		{1e21, 18, "1000000000000000000000000000000000000000", true},
+		{5, 0, "5", true},
+		{0.5, 0, "", false},
+		{1.234, 2, "", false},
+		{-1, 18, "", false},
+	}
+
+	for _, test := range tests {
+		value, err := toBaseUnits(test.amount, test.decimals)
+
+		if !test.valid {
+			if err == nil {
+				t.Errorf("toBaseUnits(%v, %d) = %s; expected an error", test.amount, test.decimals, value)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("toBaseUnits(%v, %d) failed: %v", test.amount, test.decimals, err)
+			continue
+		}
+
+		if value.String() != test.expected {
+			t.Errorf("toBaseUnits(%v, %d) = %s; expected %s", test.amount, test.decimals, value, test.expected)
+		}
+	}
+}
+
+func TestFromBaseUnits(t *testing.T) {
+	tests := []struct {
+		value    string
+		decimals uint8
+		expected float64
+	}{
+		{"0", 18, 0},
+		{"1000000000000000000", 18, 1},
+		{"100000000000000000", 18, 0.1},
+		{"123456789", 8, 1.23456789},
+		{"5", 0, 5},
+	}
+
+	for _, test := range tests {
+		value, _ := new(big.Int).SetString(test.value, 10)
+
+		if coins := fromBaseUnits(value, test.decimals); coins != test.expected {
+			t.Errorf("fromBaseUnits(%s, %d) = %v; expected %v", test.value, test.decimals, coins, test.expected)
+		}
+	}
+}
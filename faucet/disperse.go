@@ -0,0 +1,182 @@
+package faucet
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/logger"
+	"math/big"
+	"strconv"
+)
+
+// Creation code of a contract that executes a list of payouts in a single call
+//
+// The calldata is a sequence of payouts that consist of three words each: token, recipient and amount
+// Ether of the value of the call is sent if the token is the zero address; tokens are transferred from
+// the caller with "transferFrom" which requires an allowance. The whole call reverts if a single payout fails.
+// The contract has no storage and no owner; the assembly of the runtime code is:
+//
+//	    PUSH1 0x00                   offset = 0
+//	02: JUMPDEST                     loop
+//	    CALLDATASIZE DUP2 LT PUSH2 0x000b JUMPI STOP
+//	0b: JUMPDEST                     body: [offset]
+//	    DUP1 PUSH1 0x40 ADD CALLDATALOAD       [amount, offset]
+//	    DUP2 PUSH1 0x20 ADD CALLDATALOAD       [recipient, amount, offset]
+//	    DUP3 CALLDATALOAD                      [token, recipient, amount, offset]
+//	    DUP1 ISZERO PUSH2 0x0072 JUMPI
+//	    PUSH32 0x23b872dd << 224 PUSH1 0x00 MSTORE    transferFrom(caller, recipient, amount)
+//	    CALLER PUSH1 0x04 MSTORE DUP2 PUSH1 0x24 MSTORE DUP3 PUSH1 0x44 MSTORE
+//	    PUSH1 0x20 PUSH1 0x00 PUSH1 0x64 PUSH1 0x00 PUSH1 0x00 DUP6 GAS CALL
+//	    ISZERO PUSH2 0x008f JUMPI
+//	    RETURNDATASIZE ISZERO PUSH2 0x0084 JUMPI  tokens that return nothing succeeded
+//	    PUSH1 0x00 MLOAD ISZERO PUSH2 0x008f JUMPI PUSH2 0x0084 JUMP
+//	72: JUMPDEST                     ether
+//	    PUSH1 0x00 PUSH1 0x00 PUSH1 0x00 PUSH1 0x00 DUP7 DUP7 GAS CALL
+//	    ISZERO PUSH2 0x008f JUMPI
+//	84: JUMPDEST                     next
+//	    POP POP POP PUSH1 0x60 ADD PUSH2 0x0002 JUMP
+//	8f: JUMPDEST                     fail
+//	    PUSH1 0x00 DUP1 REVERT
+var disperseCode = common.FromHex(
+	"609480600b6000396000f3" +
+		"60005b36811061000b57005b8060400135816020013582358015610072577f23b872dd00000000000000000000000000000000" +
+		"00000000000000000000000060005233600452816024528260445260206000606460006000855af11561008f573d156100845760" +
+		"00511561008f57610084565b600060006000600086865af11561008f575b505050606001610002565b600080fd",
+)
+
+// Transfer of Ether or a token that is executed by the disperse contract
+type Payout struct {
+	// Ether is sent if not set
+	TokenAddress string
+	Recipient    string
+	// Amount in the smallest unit of the currency
+	Amount *big.Int
+}
+
+// Deploys the disperse contract and blocks until the deployment was mined
+func (eth *Ethereum) DeployDisperse() (common.Address, error) {
+	sendLock.Lock()
+
+	address := crypto.CreateAddress(eth.account.Address, eth.nonce)
+	transaction, err := eth.sendTransaction("disperse contract deployment", nil, big.NewInt(0), 0, disperseCode)
+
+	sendLock.Unlock()
+
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	status, err := transaction.Wait(eth.ctx)
+
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if status != TransactionMined {
+		return common.Address{}, errors.New("deployment transaction " + transaction.Hash().String() + " " + string(status))
+	}
+
+	logger.Info("Deployed disperse contract at: " + address.String())
+	return address, nil
+}
+
+// Sends all payouts in a single call to the disperse contract
+func (eth *Ethereum) SendPayouts(contract common.Address, payouts []Payout) (*Transaction, error) {
+	for _, payout := range payouts {
+		if payout.TokenAddress == "" {
+			continue
+		}
+
+		if err := eth.ensureAllowance(common.HexToAddress(payout.TokenAddress), contract); err != nil {
+			return nil, errors.New("could not approve " + payout.TokenAddress + ": " + err.Error())
+		}
+	}
+
+	value, data := encodePayouts(payouts)
+
+	sendLock.Lock()
+	defer sendLock.Unlock()
+
+	return eth.sendTransaction("batch of "+strconv.Itoa(len(payouts))+" payouts", &contract, value, 0, data)
+}
+
+// Returns the Ether that has to be sent along and the calldata for the disperse contract
+func encodePayouts(payouts []Payout) (*big.Int, []byte) {
+	value := big.NewInt(0)
+	var data []byte
+
+	for _, payout := range payouts {
+		if payout.TokenAddress == "" {
+			value.Add(value, payout.Amount)
+		}
+
+		data = append(data, common.LeftPadBytes(common.FromHex(payout.TokenAddress), 32)...)
+		data = append(data, common.LeftPadBytes(common.HexToAddress(payout.Recipient).Bytes(), 32)...)
+		data = append(data, common.LeftPadBytes(payout.Amount.Bytes(), 32)...)
+	}
+
+	return value, data
+}
+
+// Approves the maximal amount of the token for the spender if the current allowance is below half of that
+// Blocks until the approval was mined because the gas of payouts cannot be estimated without it
+func (eth *Ethereum) ensureAllowance(token common.Address, spender common.Address) error {
+	eth.allowanceLock.Lock()
+	defer eth.allowanceLock.Unlock()
+
+	if eth.approved[token] {
+		return nil
+	}
+
+	var data []byte
+
+	data = append(data, getFunctionSelector("allowance(address,address)")...)
+	data = append(data, common.LeftPadBytes(eth.account.Address.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(spender.Bytes(), 32)...)
+
+	result, err := eth.client.CallContract(eth.ctx, ethereum.CallMsg{
+		From: eth.account.Address,
+		To:   &token,
+		Data: data,
+	}, nil)
+
+	if err != nil {
+		return err
+	}
+
+	threshold := new(big.Int).Rsh(math.MaxBig256, 1)
+
+	if new(big.Int).SetBytes(result).Cmp(threshold) < 0 {
+		data = nil
+		data = append(data, getFunctionSelector("approve(address,uint256)")...)
+		data = append(data, common.LeftPadBytes(spender.Bytes(), 32)...)
+		data = append(data, common.LeftPadBytes(math.MaxBig256.Bytes(), 32)...)
+
+		sendLock.Lock()
+		transaction, err := eth.sendTransaction("approval of "+token.String(), &token, big.NewInt(0), 0, data)
+		sendLock.Unlock()
+
+		if err != nil {
+			return err
+		}
+
+		status, err := transaction.Wait(eth.ctx)
+
+		if err != nil {
+			return err
+		}
+
+		if status != TransactionMined {
+			return errors.New("approval transaction " + transaction.Hash().String() + " " + string(status))
+		}
+	}
+
+	if eth.approved == nil {
+		eth.approved = map[common.Address]bool{}
+	}
+
+	eth.approved[token] = true
+	return nil
+}
@@ -0,0 +1,192 @@
+package faucet
+
+import (
+	"bytes"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"math/big"
+	"testing"
+)
+
+var (
+	testSender     = common.HexToAddress("0x1000000000000000000000000000000000000001")
+	testRecipient  = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	testRecipient2 = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	testToken      = common.HexToAddress("0x4000000000000000000000000000000000000004")
+)
+
+// Runtime code of tokens that behave differently when "transferFrom" is called
+var (
+	// Stores the first four words of the calldata in the slots 0 to 3 and returns true
+	recordingToken = common.FromHex("600035600055600435600155602435600255604435600355600160005260206000f3")
+	silentToken    = common.FromHex("00")
+	falseToken     = common.FromHex("600060005260206000f3")
+	revertingToken = common.FromHex("60006000fd")
+)
+
+func newDisperseTestConfig(t *testing.T) (*runtime.Config, common.Address) {
+	statedb, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statedb.AddBalance(testSender, big.NewInt(1e18))
+
+	cfg := &runtime.Config{
+		Origin:   testSender,
+		State:    statedb,
+		GasLimit: 10000000,
+	}
+
+	code, address, _, err := runtime.Create(disperseCode, cfg)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(code) == 0 {
+		t.Fatal("deployment returned no runtime code")
+	}
+
+	return cfg, address
+}
+
+func TestEncodePayouts(t *testing.T) {
+	payouts := []Payout{
+		{Recipient: testRecipient.String(), Amount: big.NewInt(5)},
+		{TokenAddress: testToken.String(), Recipient: testRecipient2.String(), Amount: big.NewInt(7)},
+		{Recipient: testRecipient2.String(), Amount: big.NewInt(11)},
+	}
+
+	value, data := encodePayouts(payouts)
+
+	if value.Int64() != 16 {
+		t.Errorf("value = %s; expected 16", value)
+	}
+
+	expected := [][]byte{
+		common.LeftPadBytes(nil, 32), common.LeftPadBytes(testRecipient.Bytes(), 32), common.LeftPadBytes([]byte{5}, 32),
+		common.LeftPadBytes(testToken.Bytes(), 32), common.LeftPadBytes(testRecipient2.Bytes(), 32), common.LeftPadBytes([]byte{7}, 32),
+		common.LeftPadBytes(nil, 32), common.LeftPadBytes(testRecipient2.Bytes(), 32), common.LeftPadBytes([]byte{11}, 32),
+	}
+
+	if !bytes.Equal(data, bytes.Join(expected, nil)) {
+		t.Errorf("unexpected calldata: %x", data)
+	}
+}
+
+func TestDisperseEther(t *testing.T) {
+	tests := []struct {
+		name  string
+		value int64
+		valid bool
+	}{
+		{"exact value", 12, true},
+		{"insufficient value", 11, false},
+	}
+
+	for _, test := range tests {
+		cfg, contract := newDisperseTestConfig(t)
+		cfg.Value = big.NewInt(test.value)
+
+		_, data := encodePayouts([]Payout{
+			{Recipient: testRecipient.String(), Amount: big.NewInt(5)},
+			{Recipient: testRecipient2.String(), Amount: big.NewInt(7)},
+		})
+
+		_, _, err := runtime.Call(contract, data, cfg)
+
+		if (err == nil) != test.valid {
+			t.Errorf("%s: unexpected result: %v", test.name, err)
+			continue
+		}
+
+		if !test.valid {
+			continue
+		}
+
+		if balance := cfg.State.GetBalance(testRecipient); balance.Int64() != 5 {
+			t.Errorf("%s: balance of first recipient is %s", test.name, balance)
+		}
+
+		if balance := cfg.State.GetBalance(testRecipient2); balance.Int64() != 7 {
+			t.Errorf("%s: balance of second recipient is %s", test.name, balance)
+		}
+	}
+}
+
+func TestDisperseTokens(t *testing.T) {
+	tests := []struct {
+		name  string
+		code  []byte
+		valid bool
+	}{
+		{"token returns true", recordingToken, true},
+		{"token returns nothing", silentToken, true},
+		{"token returns false", falseToken, false},
+		{"token reverts", revertingToken, false},
+	}
+
+	for _, test := range tests {
+		cfg, contract := newDisperseTestConfig(t)
+		cfg.State.SetCode(testToken, test.code)
+
+		_, data := encodePayouts([]Payout{
+			{TokenAddress: testToken.String(), Recipient: testRecipient.String(), Amount: big.NewInt(9)},
+		})
+
+		_, _, err := runtime.Call(contract, data, cfg)
+
+		if (err == nil) != test.valid {
+			t.Errorf("%s: unexpected result: %v", test.name, err)
+		}
+	}
+}
+
+func TestDisperseTransferFromCalldata(t *testing.T) {
+	cfg, contract := newDisperseTestConfig(t)
+	cfg.State.SetCode(testToken, recordingToken)
+
+	_, data := encodePayouts([]Payout{
+		{TokenAddress: testToken.String(), Recipient: testRecipient.String(), Amount: big.NewInt(9)},
+	})
+
+	if _, _, err := runtime.Call(contract, data, cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	slot := func(index int64) []byte {
+		return cfg.State.GetState(testToken, common.BigToHash(big.NewInt(index))).Bytes()
+	}
+
+	if selector := slot(0)[:4]; !bytes.Equal(selector, getFunctionSelector("transferFrom(address,address,uint256)")) {
+		t.Errorf("unexpected selector: %x", selector)
+	}
+
+	tests := []struct {
+		name     string
+		slot     int64
+		expected []byte
+	}{
+		{"from", 1, common.LeftPadBytes(testSender.Bytes(), 32)},
+		{"to", 2, common.LeftPadBytes(testRecipient.Bytes(), 32)},
+		{"amount", 3, common.LeftPadBytes([]byte{9}, 32)},
+	}
+
+	for _, test := range tests {
+		if value := slot(test.slot); !bytes.Equal(value, test.expected) {
+			t.Errorf("%s = %x; expected %x", test.name, value, test.expected)
+		}
+	}
+}
+
+func TestDisperseEmptyCalldata(t *testing.T) {
+	cfg, contract := newDisperseTestConfig(t)
+
+	if _, _, err := runtime.Call(contract, nil, cfg); err != nil {
+		t.Errorf("call without payouts failed: %v", err)
+	}
+}
@@ -5,10 +5,8 @@ import (
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/google/logger"
-	"golang.org/x/crypto/sha3"
 	"io/ioutil"
 	"math/big"
 	"sync"
@@ -16,17 +14,22 @@ import (
 
 var sendLock = &sync.Mutex{}
 
-// 1 gwei is enough for our simnet
-var gasPrice = big.NewInt(1000000000)
-
-var ethTransferGasLimit = uint64(21000)
-var erc20TransferGasLimit = uint64(500000)
-
 type Ethereum struct {
 	RPCHost      string `long:"eth.rpcuri" description:"URI of the RPC interface of an Ethereum client"`
 	KeystorePath string `long:"eth.keystore" description:"Path to the keystore of the Ethereum address"`
 	Password     string `long:"eth.password" description:"Password of the keystore"`
 
+	ReceiptInterval int `long:"eth.receiptinterval" default:"5" description:"Interval in seconds at which the receipts of pending transactions should be queried"`
+	ReplaceTimeout  int `long:"eth.replacetimeout" default:"120" description:"Time in seconds after which pending transactions are replaced with a higher gas price"`
+	MaxReplacements int `long:"eth.maxreplacements" default:"3" description:"Number of times a pending transaction is replaced before it is considered dropped"`
+	GasPriceBump    int `long:"eth.gaspricebump" default:"25" description:"Percentage by which the gas price of replaced transactions is increased"`
+
+	GasPriceMultiplier float64 `long:"eth.gaspricemultiplier" default:"1" description:"Factor by which the gas price or priority fee suggested by the Ethereum client is multiplied"`
+	MaxGasPrice        int64   `long:"eth.maxgasprice" default:"100" description:"Maximal gas price or fee cap in gwei"`
+	GasLimitMultiplier float64 `long:"eth.gaslimitmultiplier" default:"1.2" description:"Factor by which the estimated gas of transactions is multiplied"`
+	MaxGasLimit        uint64  `long:"eth.maxgaslimit" default:"500000" description:"Maximal gas limit of transactions whose gas is estimated; 0 disables the cap"`
+	LegacyTransactions bool    `long:"eth.legacytransactions" description:"Send legacy transactions even if the chain supports dynamic fees"`
+
 	chainID *big.Int
 	client  *ethclient.Client
 
@@ -36,9 +39,23 @@ type Ethereum struct {
 	account  accounts.Account
 
 	nonce uint64
+
+	transactions     []*Transaction
+	transactionsLock sync.Mutex
+	// Tracks the goroutine that checks the receipts of the transactions
+	tracker sync.WaitGroup
+
+	// Cache of the decimals of token contracts
+	decimals     map[common.Address]uint8
+	decimalsLock sync.Mutex
+
+	// Set of the tokens whose allowance for the disperse contract is sufficient
+	approved      map[common.Address]bool
+	allowanceLock sync.Mutex
 }
 
-func (eth *Ethereum) Init() error {
+// All calls to the Ethereum client are canceled and the tracking of transactions stops once the context is done
+func (eth *Ethereum) Init(ctx context.Context) error {
 	var err error
 	eth.client, err = ethclient.Dial(eth.RPCHost)
 
@@ -46,11 +63,9 @@ func (eth *Ethereum) Init() error {
 		return err
 	}
 
-	if eth.ctx == nil {
-		eth.ctx = context.Background()
-	}
+	eth.ctx = ctx
 
-	eth.chainID, err = eth.client.NetworkID(eth.ctx)
+	eth.chainID, err = eth.client.ChainID(eth.ctx)
 
 	if err != nil {
 		return err
@@ -81,66 +96,48 @@ func (eth *Ethereum) Init() error {
 		return err
 	}
 
+	eth.tracker.Add(1)
+
+	go func() {
+		defer eth.tracker.Done()
+		eth.trackTransactions()
+	}()
+
 	logger.Info("Initialized Ethereum client with address: " + eth.account.Address.String())
 
 	return nil
 }
 
-func (eth *Ethereum) SendEther(address string, amount *big.Int) error {
+// Has to be called after the context was canceled
+func (eth *Ethereum) Close() {
+	eth.tracker.Wait()
+	eth.client.Close()
+}
+
+func (eth *Ethereum) SendEther(address string, amount *big.Int, gasLimit uint64) (*Transaction, error) {
 	sendLock.Lock()
 	defer sendLock.Unlock()
 
 	recipient := common.HexToAddress(address)
 
-	transaction := types.NewTransaction(eth.nonce, recipient, amount, ethTransferGasLimit, gasPrice, nil)
-	transaction, err := eth.keystore.SignTx(eth.account, transaction, eth.chainID)
-
-	if err != nil {
-		return err
-	}
-
-	logger.Info("Sending ETH to " + address + ": " + transaction.Hash().String())
-
-	eth.nonce += 1
-
-	return eth.client.SendTransaction(eth.ctx, transaction)
+	return eth.sendTransaction("ETH to "+address, &recipient, amount, gasLimit, nil)
 }
 
-func (eth *Ethereum) SendToken(token string, address string, amount string) error {
+func (eth *Ethereum) SendToken(token string, address string, amount string, gasLimit uint64) (*Transaction, error) {
 	sendLock.Lock()
 	defer sendLock.Unlock()
 
 	tokenAddress := common.HexToAddress(token)
 	recipient := common.HexToAddress(address)
 
-	transferFunctionSignature := []byte("transfer(address,uint256)")
-
-	hash := sha3.NewLegacyKeccak256()
-	_, err := hash.Write(transferFunctionSignature)
-
-	if err != nil {
-		return err
-	}
-
 	tokenAmount := new(big.Int)
 	tokenAmount.SetString(amount, 10)
 
 	var data []byte
 
-	data = append(data, hash.Sum(nil)[:4]...)
+	data = append(data, getFunctionSelector("transfer(address,uint256)")...)
 	data = append(data, common.LeftPadBytes(recipient.Bytes(), 32)...)
 	data = append(data, common.LeftPadBytes(tokenAmount.Bytes(), 32)...)
 
-	transaction := types.NewTransaction(eth.nonce, tokenAddress, big.NewInt(0), erc20TransferGasLimit, gasPrice, data)
-	transaction, err = eth.keystore.SignTx(eth.account, transaction, eth.chainID)
-
-	if err != nil {
-		return err
-	}
-
-	logger.Info("Sending " + token + " to " + address + ": " + transaction.Hash().String())
-
-	eth.nonce += 1
-
-	return eth.client.SendTransaction(eth.ctx, transaction)
+	return eth.sendTransaction(token+" to "+address, &tokenAddress, big.NewInt(0), gasLimit, data)
 }
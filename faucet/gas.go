@@ -0,0 +1,163 @@
+package faucet
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+var gwei = big.NewInt(1000000000)
+
+// Fees of a transaction; either the gas price of a legacy transaction or the caps of a dynamic fee transaction are set
+type gasFees struct {
+	gasPrice *big.Int
+
+	tipCap *big.Int
+	feeCap *big.Int
+}
+
+// Uses dynamic fees if the latest block has a base fee and legacy gas prices otherwise
+func (eth *Ethereum) getGasFees() (*gasFees, error) {
+	maxGasPrice := new(big.Int).Mul(big.NewInt(eth.MaxGasPrice), gwei)
+
+	if !eth.LegacyTransactions {
+		header, err := eth.client.HeaderByNumber(eth.ctx, nil)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if header.BaseFee != nil {
+			tipCap, err := eth.client.SuggestGasTipCap(eth.ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			tipCap = multiply(tipCap, eth.GasPriceMultiplier)
+
+			// Leaves room for the base fee to double before the transaction is not includable anymore
+			feeCap := new(big.Int).Add(new(big.Int).Mul(header.BaseFee, big.NewInt(2)), tipCap)
+
+			return &gasFees{
+				tipCap: minimum(tipCap, maxGasPrice),
+				feeCap: minimum(feeCap, maxGasPrice),
+			}, nil
+		}
+	}
+
+	gasPrice, err := eth.client.SuggestGasPrice(eth.ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &gasFees{
+		gasPrice: minimum(multiply(gasPrice, eth.GasPriceMultiplier), maxGasPrice),
+	}, nil
+}
+
+// Returns the fees increased by the gas price bump or an error if that would exceed the maximal gas price
+func (eth *Ethereum) bumpGasFees(transaction *types.Transaction) (*gasFees, error) {
+	maxGasPrice := new(big.Int).Mul(big.NewInt(eth.MaxGasPrice), gwei)
+	factor := float64(100+eth.GasPriceBump) / 100
+
+	var fees *gasFees
+	var highest *big.Int
+
+	if transaction.Type() == types.DynamicFeeTxType {
+		fees = &gasFees{
+			tipCap: multiply(transaction.GasTipCap(), factor),
+			feeCap: multiply(transaction.GasFeeCap(), factor),
+		}
+		highest = fees.feeCap
+	} else {
+		fees = &gasFees{
+			gasPrice: multiply(transaction.GasPrice(), factor),
+		}
+		highest = fees.gasPrice
+	}
+
+	if highest.Cmp(maxGasPrice) > 0 {
+		return nil, errors.New("gas price would exceed the maximum of " + strconv.FormatInt(eth.MaxGasPrice, 10) + " gwei")
+	}
+
+	return fees, nil
+}
+
+func (fees *gasFees) String() string {
+	if fees.gasPrice != nil {
+		return "gas price " + fees.gasPrice.String()
+	}
+
+	return "tip cap " + fees.tipCap.String() + " and fee cap " + fees.feeCap.String()
+}
+
+// Contracts are created if the recipient is nil
+func (eth *Ethereum) buildTransaction(nonce uint64, to *common.Address, value *big.Int, gasLimit uint64, fees *gasFees, data []byte) *types.Transaction {
+	if fees.gasPrice != nil {
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: fees.gasPrice,
+			Gas:      gasLimit,
+			To:       to,
+			Value:    value,
+			Data:     data,
+		})
+	}
+
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   eth.chainID,
+		Nonce:     nonce,
+		GasTipCap: fees.tipCap,
+		GasFeeCap: fees.feeCap,
+		Gas:       gasLimit,
+		To:        to,
+		Value:     value,
+		Data:      data,
+	})
+}
+
+// Estimates the gas of the transaction with some headroom in case the state changes before it is mined
+func (eth *Ethereum) estimateGas(to *common.Address, value *big.Int, data []byte) (uint64, error) {
+	estimate, err := eth.client.EstimateGas(eth.ctx, ethereum.CallMsg{
+		From:  eth.account.Address,
+		To:    to,
+		Value: value,
+		Data:  data,
+	})
+
+	if err != nil {
+		return 0, errors.New("could not estimate gas: " + err.Error())
+	}
+
+	gasLimit := uint64(math.Ceil(float64(estimate) * eth.GasLimitMultiplier))
+
+	if eth.MaxGasLimit != 0 && gasLimit > eth.MaxGasLimit {
+		if estimate > eth.MaxGasLimit {
+			return 0, errors.New("estimated gas of " + strconv.FormatUint(estimate, 10) + " exceeds the maximum of " +
+				strconv.FormatUint(eth.MaxGasLimit, 10))
+		}
+
+		gasLimit = eth.MaxGasLimit
+	}
+
+	return gasLimit, nil
+}
+
+func multiply(value *big.Int, factor float64) *big.Int {
+	result, _ := new(big.Float).Mul(new(big.Float).SetInt(value), big.NewFloat(factor)).Int(nil)
+	return result
+}
+
+func minimum(a *big.Int, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,28 @@
+package faucet
+
+import (
+	"errors"
+	"github.com/ExchangeUnion/xud-simnet-bot/metrics"
+)
+
+func (faucet *Faucet) registerMetrics() {
+	metrics.RegisterQueriedGauge("faucet_balance", "Balance of the faucet wallet per currency in whole coins", []string{"currency"}, faucet.queryBalances)
+}
+
+func (faucet *Faucet) queryBalances() (values []metrics.GaugeValue, err error) {
+	for _, asset := range faucet.getAssets() {
+		balance, queryError := faucet.eth.GetAssetBalance(asset)
+
+		if queryError != nil {
+			err = errors.New("could not query " + asset.Currency + " balance: " + queryError.Error())
+			continue
+		}
+
+		values = append(values, metrics.GaugeValue{
+			Labels: []string{asset.Currency},
+			Value:  balance,
+		})
+	}
+
+	return values, err
+}
@@ -0,0 +1,316 @@
+package faucet
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"github.com/ExchangeUnion/xud-simnet-bot/database"
+	"github.com/ExchangeUnion/xud-simnet-bot/metrics"
+	"github.com/google/logger"
+	"strconv"
+	"time"
+)
+
+type queuedResponse struct {
+	ID    string                      `json:"id"`
+	State database.FaucetRequestState `json:"state"`
+}
+
+type requestStatusResponse struct {
+	ID        string                      `json:"id"`
+	Address   string                      `json:"address"`
+	State     database.FaucetRequestState `json:"state"`
+	Transfers []transferResult            `json:"transfers"`
+	Error     string                      `json:"error,omitempty"`
+	CreatedAt time.Time                   `json:"createdAt"`
+	UpdatedAt time.Time                   `json:"updatedAt"`
+}
+
+var errQueueFull = errors.New("too many requests are queued")
+
+// Maximal number of requests that are handed over to the payout batcher at the same time
+const maxConcurrentRequests = 256
+
+// Interval at which finished requests that are older than the retention period are removed
+var pruneInterval = time.Hour
+
+// Adds a request to the persistent queue of the dispatcher
+func (faucet *Faucet) enqueue(address string, client string, fromDiscord bool) (database.FaucetRequest, error) {
+	faucet.queueLock.Lock()
+	defer faucet.queueLock.Unlock()
+
+	if faucet.MaxQueueSize > 0 {
+		pending, err := faucet.database.CountPendingFaucetRequests()
+
+		if err != nil {
+			return database.FaucetRequest{}, err
+		}
+
+		if pending >= faucet.MaxQueueSize {
+			return database.FaucetRequest{}, errQueueFull
+		}
+	}
+
+	id, err := generateRequestID()
+
+	if err != nil {
+		return database.FaucetRequest{}, err
+	}
+
+	request := database.FaucetRequest{
+		ID:        id,
+		Address:   address,
+		State:     database.FaucetRequestQueued,
+		Client:    client,
+		Discord:   fromDiscord,
+		CreatedAt: time.Now(),
+	}
+
+	if err := faucet.database.SetFaucetRequest(request); err != nil {
+		return database.FaucetRequest{}, err
+	}
+
+	faucet.wakeDispatcher()
+
+	return request, nil
+}
+
+// Does not block if the dispatcher was already woken up
+func (faucet *Faucet) wakeDispatcher() {
+	select {
+	case faucet.dispatchSignal <- struct{}{}:
+	default:
+	}
+}
+
+// Sends the queued requests until the context is done
+// Requests are sent one after another unless payouts are batched; then all of them are handed over to the batcher at once
+func (faucet *Faucet) dispatch() {
+	defer faucet.workers.Done()
+
+	faucet.recoverRequests()
+	faucet.pruneRequests()
+
+	pruneTicker := time.NewTicker(pruneInterval)
+	defer pruneTicker.Stop()
+
+	for {
+		if !faucet.IsPaused() {
+			faucet.processQueue()
+		}
+
+		select {
+		case <-faucet.ctx.Done():
+			return
+
+		case <-faucet.dispatchSignal:
+		case <-pruneTicker.C:
+			faucet.pruneRequests()
+		}
+	}
+}
+
+func (faucet *Faucet) processQueue() {
+	pending, err := faucet.database.GetPendingFaucetRequests()
+
+	if err != nil {
+		logger.Error("Could not get queued faucet requests: " + err.Error())
+		return
+	}
+
+	for _, request := range pending {
+		if faucet.ctx.Err() != nil || faucet.IsPaused() {
+			return
+		}
+
+		if faucet.batcher == nil {
+			faucet.processRequest(request)
+			continue
+		}
+
+		if !faucet.startProcessing(request.ID) {
+			continue
+		}
+
+		faucet.workers.Add(1)
+
+		go func(id string) {
+			defer faucet.workers.Done()
+			defer faucet.finishProcessing(id)
+
+			// The list of pending requests could be outdated if the request was finished since it was read
+			request, err := faucet.database.GetFaucetRequest(id)
+
+			if err != nil {
+				logger.Error("Could not get faucet request " + id + ": " + err.Error())
+				return
+			}
+
+			if request != nil && request.State == database.FaucetRequestQueued {
+				faucet.processRequest(*request)
+			}
+		}(request.ID)
+	}
+}
+
+// Returns false if the request is being processed already or too many requests are
+func (faucet *Faucet) startProcessing(id string) bool {
+	faucet.processingLock.Lock()
+	defer faucet.processingLock.Unlock()
+
+	if faucet.processing[id] || len(faucet.processing) >= maxConcurrentRequests {
+		return false
+	}
+
+	faucet.processing[id] = true
+	return true
+}
+
+func (faucet *Faucet) finishProcessing(id string) {
+	faucet.processingLock.Lock()
+	delete(faucet.processing, id)
+	faucet.processingLock.Unlock()
+
+	// Requests that were skipped because too many were processed are picked up now
+	faucet.wakeDispatcher()
+}
+
+func (faucet *Faucet) processRequest(request database.FaucetRequest) {
+	request.State = database.FaucetRequestProcessing
+
+	if err := faucet.database.SetFaucetRequest(request); err != nil {
+		logger.Error("Could not update faucet request " + request.ID + ": " + err.Error())
+		return
+	}
+
+	response, err := faucet.sendTokens(request.Address)
+	response.observe(err)
+
+	for _, transfer := range response.Transfers {
+		request.Transfers = append(request.Transfers, database.FaucetTransfer{
+			Currency:        transfer.Currency,
+			Amount:          transfer.Amount,
+			TransactionHash: transfer.TransactionHash,
+			Status:          string(transfer.Status),
+			Error:           transfer.Error,
+		})
+	}
+
+	var message string
+
+	if err != nil {
+		request.State = database.FaucetRequestFailed
+		request.Error = err.Error()
+
+		if err := faucet.limiter.release(request.Address, request.Client); err != nil {
+			logger.Warning("Could not release faucet claim: " + err.Error())
+		}
+
+		message = "Could not send tokens to `" + request.Address + "`: " + err.Error()
+		logger.Warning(message)
+	} else {
+		request.State = database.FaucetRequestDone
+
+		message = "Sent tokens to `" + request.Address + "`"
+
+		if request.Discord {
+			message += " on Discord request"
+		}
+
+		if failed := response.getFailedTransfers(); failed != "" {
+			message += " but could not send " + failed
+
+			logger.Warning(message)
+		} else {
+			logger.Info(message)
+		}
+	}
+
+	if err := faucet.database.SetFaucetRequest(request); err != nil {
+		logger.Error("Could not update faucet request " + request.ID + ": " + err.Error())
+	}
+
+	_ = faucet.discord.SendMessage(message)
+}
+
+// Requests that were being processed when the faucet stopped are not sent again because some of their transactions might have been broadcast already
+func (faucet *Faucet) recoverRequests() {
+	pending, err := faucet.database.GetPendingFaucetRequests()
+
+	if err != nil {
+		logger.Error("Could not get queued faucet requests: " + err.Error())
+		return
+	}
+
+	for _, request := range pending {
+		if request.State != database.FaucetRequestProcessing {
+			continue
+		}
+
+		request.State = database.FaucetRequestFailed
+		request.Error = "faucet was stopped while the request was processed"
+
+		metrics.FaucetRequests.WithLabelValues(metrics.FaucetFailed).Inc()
+
+		if err := faucet.database.SetFaucetRequest(request); err != nil {
+			logger.Error("Could not update faucet request " + request.ID + ": " + err.Error())
+			continue
+		}
+
+		message := "Faucet request " + request.ID + " for `" + request.Address + "` was interrupted; check its transactions manually"
+
+		logger.Warning(message)
+		_ = faucet.discord.SendMessage(message)
+	}
+}
+
+func (faucet *Faucet) pruneRequests() {
+	if faucet.RequestRetention <= 0 {
+		return
+	}
+
+	pruned, err := faucet.database.PruneFaucetRequests(time.Now().Add(-time.Duration(faucet.RequestRetention) * time.Second))
+
+	if err != nil {
+		logger.Warning("Could not prune faucet requests: " + err.Error())
+		return
+	}
+
+	if pruned > 0 {
+		logger.Info("Pruned " + strconv.Itoa(pruned) + " finished faucet requests")
+	}
+}
+
+func newRequestStatusResponse(request *database.FaucetRequest) requestStatusResponse {
+	response := requestStatusResponse{
+		ID:        request.ID,
+		Address:   request.Address,
+		State:     request.State,
+		Transfers: []transferResult{},
+		Error:     request.Error,
+		CreatedAt: request.CreatedAt,
+		UpdatedAt: request.UpdatedAt,
+	}
+
+	for _, transfer := range request.Transfers {
+		response.Transfers = append(response.Transfers, transferResult{
+			Currency:        transfer.Currency,
+			Amount:          transfer.Amount,
+			TransactionHash: transfer.TransactionHash,
+			Status:          transferStatus(transfer.Status),
+			Error:           transfer.Error,
+		})
+	}
+
+	return response
+}
+
+func generateRequestID() (string, error) {
+	id := make([]byte, 16)
+
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
@@ -0,0 +1,112 @@
+package faucet
+
+import (
+	"github.com/ExchangeUnion/xud-simnet-bot/database"
+	"github.com/ethereum/go-ethereum/common"
+	"sync"
+	"time"
+)
+
+// Limits how often an address or a client (IP or Discord user) can claim tokens from the faucet
+// The claims are persisted in the database so that a restart does not reset the limits
+type rateLimiter struct {
+	addressCooldown time.Duration
+	clientCooldown  time.Duration
+
+	database *database.Database
+
+	lock sync.Mutex
+}
+
+func newRateLimiter(addressCooldown time.Duration, clientCooldown time.Duration, database *database.Database) *rateLimiter {
+	return &rateLimiter{
+		addressCooldown: addressCooldown,
+		clientCooldown:  clientCooldown,
+		database:        database,
+	}
+}
+
+// Records a claim for the address and client if neither of them is in its cooldown window
+// Returns how long the client has to wait if the claim was rejected and zero otherwise
+func (limiter *rateLimiter) claim(address string, client string) (time.Duration, error) {
+	limiter.lock.Lock()
+	defer limiter.lock.Unlock()
+
+	now := time.Now()
+
+	addressKey := addressIdentifier(address)
+
+	retryAfter, err := limiter.remainingCooldown(addressKey, limiter.addressCooldown, now)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if client != "" {
+		clientRetryAfter, err := limiter.remainingCooldown(client, limiter.clientCooldown, now)
+
+		if err != nil {
+			return 0, err
+		}
+
+		if clientRetryAfter > retryAfter {
+			retryAfter = clientRetryAfter
+		}
+	}
+
+	if retryAfter > 0 {
+		return retryAfter, nil
+	}
+
+	return 0, limiter.database.SetFaucetClaims(claimIdentifiers(address, client), now)
+}
+
+// Removes a claim that was recorded for a request that could not be fulfilled
+func (limiter *rateLimiter) release(address string, client string) error {
+	limiter.lock.Lock()
+	defer limiter.lock.Unlock()
+
+	return limiter.database.RemoveFaucetClaims(claimIdentifiers(address, client))
+}
+
+func (limiter *rateLimiter) remainingCooldown(identifier string, cooldown time.Duration, now time.Time) (time.Duration, error) {
+	if cooldown <= 0 {
+		return 0, nil
+	}
+
+	lastClaim, err := limiter.database.GetFaucetClaim(identifier)
+
+	if err != nil || lastClaim.IsZero() {
+		return 0, err
+	}
+
+	return lastClaim.Add(cooldown).Sub(now), nil
+}
+
+// The address and client of a claim are written together so that a failure cannot leave only one of them recorded
+func claimIdentifiers(address string, client string) []string {
+	identifiers := []string{addressIdentifier(address)}
+
+	if client != "" {
+		identifiers = append(identifiers, client)
+	}
+
+	return identifiers
+}
+
+// All spellings of an address share the same identifier
+func addressIdentifier(address string) string {
+	return "address:" + common.HexToAddress(address).Hex()
+}
+
+func ipIdentifier(ip string) string {
+	if ip == "" {
+		return ""
+	}
+
+	return "ip:" + ip
+}
+
+func discordIdentifier(userID string) string {
+	return "discord:" + userID
+}
@@ -1,63 +1,193 @@
 package faucet
 
 import (
+	"context"
 	"encoding/json"
-	"github.com/ExchangeUnion/xud-simnet-bot/channels"
+	"errors"
+	"github.com/ExchangeUnion/xud-simnet-bot/database"
 	"github.com/ExchangeUnion/xud-simnet-bot/discord"
+	"github.com/ExchangeUnion/xud-simnet-bot/metrics"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/google/logger"
 	"math"
 	"math/big"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 type Faucet struct {
 	Port int `long:"faucet.port" description:"Port to which the HTTP server of the faucet will listen"`
 
-	channels []channels.Channel
+	Cooldown   int  `long:"faucet.cooldown" default:"86400" description:"Time in seconds an address has to wait before it can request tokens again"`
+	IPCooldown int  `long:"faucet.ipcooldown" default:"3600" description:"Time in seconds an IP address or Discord user has to wait before it can request tokens again"`
+	TrustProxy bool `long:"faucet.trustproxy" description:"Whether the IP of clients should be read from the X-Forwarded-For header"`
+	ProxyHops  int  `long:"faucet.proxyhops" default:"1" description:"Number of trusted proxies that append to the X-Forwarded-For header; 0 uses the address of the connection"`
 
-	eth     *Ethereum
-	discord *discord.Discord
+	MaxQueueSize     int `long:"faucet.maxqueue" default:"100" description:"Maximal number of requests that are queued; 0 disables the limit"`
+	RequestRetention int `long:"faucet.requestretention" default:"604800" description:"Time in seconds for which the status of finished requests can be queried; 0 keeps them forever"`
+
+	ChallengeDifficulty int    `long:"faucet.challengedifficulty" description:"Number of leading zero bits of the proof of work that has to be solved for every request; 0 disables"`
+	ChallengeTimeout    int    `long:"faucet.challengetimeout" default:"300" description:"Time in seconds after which challenges that were not solved expire"`
+	Captcha             string `long:"faucet.captcha" description:"Verifier of the captcha that has to be solved for every request: siteverify or stub; disabled if not set"`
+	CaptchaURL          string `long:"faucet.captchaurl" description:"URL of the siteverify API of the captcha provider"`
+	CaptchaSecret       string `long:"faucet.captchasecret" description:"Secret for the siteverify API of the captcha provider"`
+
+	BatchPayouts    bool   `long:"faucet.batch" description:"Send all currencies of a request in a single transaction through a disperse contract"`
+	BatchWindow     int    `long:"faucet.batchwindow" description:"Time in seconds during which the payouts of multiple requests are collected into a single transaction; 0 sends every request on its own"`
+	MaxBatchPayouts int    `long:"faucet.maxbatchpayouts" default:"10" description:"Maximal number of payouts per batch transaction; has to be low enough for the transaction to stay below eth.maxgaslimit; 0 disables the limit"`
+	DisperseAddress string `long:"faucet.disperseaddress" description:"Address of the disperse contract; it is deployed by the faucet if not set"`
+
+	assets     []Asset
+	assetsLock sync.RWMutex
+
+	limiter *rateLimiter
+	batcher *payoutBatcher
+
+	challenger *challenger
+	// Set with SetCaptchaVerifier to use a verifier that cannot be configured
+	captchaVerifier CaptchaVerifier
+
+	// Guards the check of the queue size and the insertion of new requests
+	queueLock sync.Mutex
+	// Wakes the dispatcher up when a request was queued or the faucet resumed
+	dispatchSignal chan struct{}
+
+	// Set of the IDs of the requests that were handed over to the payout batcher
+	processing     map[string]bool
+	processingLock sync.Mutex
+
+	ctx context.Context
+	// Goroutines that have to finish before the clients the faucet uses are closed
+	workers sync.WaitGroup
+
+	// Set to 1 while requests for tokens are rejected
+	paused int32
+
+	eth      *Ethereum
+	discord  *discord.Discord
+	database *database.Database
 }
 
 type faucetRequest struct {
 	Address string `json:"address"`
+
+	// Solution of the proof of work challenge
+	Challenge string `json:"challenge,omitempty"`
+	Nonce     string `json:"nonce,omitempty"`
+
+	CaptchaToken string `json:"captchaToken,omitempty"`
 }
 
 type faucetResponse struct {
+	// Map between the currencies that were sent successfully and their amounts
 	TokensSent map[string]string `json:"tokensSent"`
+	Transfers  []transferResult  `json:"transfers"`
+}
+
+type transferStatus string
+
+const (
+	transferSuccess transferStatus = "success"
+	transferError   transferStatus = "error"
+)
+
+type transferResult struct {
+	Currency string `json:"currency"`
+	// Amount in the smallest unit of the currency
+	Amount          string         `json:"amount"`
+	TransactionHash string         `json:"transactionHash,omitempty"`
+	Status          transferStatus `json:"status"`
+	Error           string         `json:"error,omitempty"`
 }
 
 type errorResponse struct {
 	Error string `json:"error"`
+	// Seconds after which the client is allowed to request tokens again
+	RetryAfter int64 `json:"retryAfter,omitempty"`
 }
 
-var decimals = big.NewFloat(math.Pow(10, 18))
+// Time requests that are being handled get to finish when the faucet is shut down
+var shutdownTimeout = 10 * time.Second
 
-func (faucet *Faucet) Start(channels []channels.Channel, eth *Ethereum, discord *discord.Discord) {
+// Serves the currencies that were set until the context is done
+func (faucet *Faucet) Start(ctx context.Context, eth *Ethereum, discord *discord.Discord, database *database.Database) {
 	logger.Info("Starting faucet at port: " + strconv.Itoa(faucet.Port))
 
-	var channelNames []string
+	faucet.ctx = ctx
+
+	faucet.eth = eth
+	faucet.discord = discord
+	faucet.database = database
+
+	faucet.dispatchSignal = make(chan struct{}, 1)
+	faucet.processing = map[string]bool{}
 
-	for _, channel := range channels {
-		channelNames = append(channelNames, channel.Currency)
+	if faucet.BatchPayouts {
+		if err := faucet.initBatching(database); err != nil {
+			logger.Fatal("Could not initialize batch payouts: " + err.Error())
+		}
 	}
 
-	logger.Info("Faucet currencies: " + strings.Join(channelNames, ", "))
+	if faucet.captchaVerifier == nil {
+		verifier, err := faucet.getCaptchaVerifier()
 
-	faucet.channels = channels
+		if err != nil {
+			logger.Fatal("Could not initialize captcha: " + err.Error())
+		}
 
-	faucet.eth = eth
-	faucet.discord = discord
+		faucet.captchaVerifier = verifier
+	}
+
+	challenger, err := newChallenger(
+		faucet.ChallengeDifficulty,
+		time.Duration(faucet.ChallengeTimeout)*time.Second,
+		faucet.captchaVerifier,
+	)
+
+	if err != nil {
+		logger.Fatal("Could not initialize challenges: " + err.Error())
+	}
+
+	faucet.challenger = challenger
+
+	faucet.limiter = newRateLimiter(
+		time.Duration(faucet.Cooldown)*time.Second,
+		time.Duration(faucet.IPCooldown)*time.Second,
+		database,
+	)
+
+	faucet.registerCommands()
+	faucet.registerMetrics()
+
+	faucet.workers.Add(1)
+	go faucet.dispatch()
+
+	// The default mux must not be used because packages like expvar register debug handlers on it
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/faucet", func(writer http.ResponseWriter, request *http.Request) {
+		if faucet.IsPaused() {
+			metrics.FaucetRequests.WithLabelValues(metrics.FaucetPaused).Inc()
+
+			writeResponse(writer, http.StatusServiceUnavailable, errorResponse{
+				Error: "faucet is paused",
+			})
+			return
+		}
 
-	http.HandleFunc("/faucet", func(writer http.ResponseWriter, request *http.Request) {
 		decoder := json.NewDecoder(request.Body)
 
 		var resultBody faucetRequest
 		err := decoder.Decode(&resultBody)
 
 		if err != nil {
+			metrics.FaucetRequests.WithLabelValues(metrics.FaucetInvalid).Inc()
+
 			writeResponse(writer, 400, errorResponse{
 				Error: "could not parse request: " + err.Error(),
 			})
@@ -65,66 +195,395 @@ func (faucet *Faucet) Start(channels []channels.Channel, eth *Ethereum, discord
 		}
 
 		if resultBody.Address == "" {
+			metrics.FaucetRequests.WithLabelValues(metrics.FaucetInvalid).Inc()
+
 			writeResponse(writer, 400, errorResponse{
-				"no address was provided",
+				Error: "no address was provided",
 			})
 			return
 		}
 
-		response, err := faucet.sendTokens(resultBody.Address)
+		if !common.IsHexAddress(resultBody.Address) {
+			metrics.FaucetRequests.WithLabelValues(metrics.FaucetInvalid).Inc()
 
-		if err != nil {
 			writeResponse(writer, 400, errorResponse{
-				Error: "could not send tokens: " + err.Error(),
+				Error: "invalid address: " + resultBody.Address,
+			})
+			return
+		}
+
+		clientIP := faucet.getClientIP(request)
+
+		if faucet.challenger.enabled() {
+			if err := faucet.challenger.verify(resultBody, clientIP); err != nil {
+				metrics.FaucetRequests.WithLabelValues(metrics.FaucetChallengeFailed).Inc()
+
+				writeResponse(writer, http.StatusForbidden, errorResponse{
+					Error: "challenge failed: " + err.Error(),
+				})
+				return
+			}
+		}
+
+		// Different spellings of the same address must not be treated as different recipients
+		address := common.HexToAddress(resultBody.Address).Hex()
+		client := ipIdentifier(clientIP)
+
+		retryAfter, err := faucet.limiter.claim(address, client)
+
+		if err != nil {
+			metrics.FaucetRequests.WithLabelValues(metrics.FaucetFailed).Inc()
+			logger.Error("Could not check faucet rate limit: " + err.Error())
+
+			writeResponse(writer, http.StatusInternalServerError, errorResponse{
+				Error: "could not check rate limit",
+			})
+			return
+		}
+
+		if retryAfter > 0 {
+			retryAfterSeconds := int64(math.Ceil(retryAfter.Seconds()))
+			metrics.FaucetRequests.WithLabelValues(metrics.FaucetRateLimited).Inc()
+
+			writer.Header().Set("Retry-After", strconv.FormatInt(retryAfterSeconds, 10))
+			writeResponse(writer, http.StatusTooManyRequests, errorResponse{
+				Error:      "tokens were requested too recently",
+				RetryAfter: retryAfterSeconds,
 			})
+			return
+		}
+
+		queued, err := faucet.enqueue(address, client, false)
+
+		if err != nil {
+			if err := faucet.limiter.release(address, client); err != nil {
+				logger.Warning("Could not release faucet claim: " + err.Error())
+			}
+
+			if err == errQueueFull {
+				metrics.FaucetRequests.WithLabelValues(metrics.FaucetQueueFull).Inc()
 
-			message := "Could not send tokens: " + err.Error()
+				writeResponse(writer, http.StatusServiceUnavailable, errorResponse{
+					Error: err.Error(),
+				})
+				return
+			}
 
-			logger.Warning(message)
-			_ = discord.SendMessage(message)
+			metrics.FaucetRequests.WithLabelValues(metrics.FaucetFailed).Inc()
+			logger.Error("Could not queue faucet request: " + err.Error())
 
+			writeResponse(writer, http.StatusInternalServerError, errorResponse{
+				Error: "could not queue request",
+			})
 			return
 		}
 
-		writeResponse(writer, 200, response)
+		logger.Info("Queued faucet request " + queued.ID + " for " + address)
 
-		message := "Sent tokens to `" + resultBody.Address + "`"
+		writer.Header().Set("Location", "/faucet/"+queued.ID)
+		writeResponse(writer, http.StatusAccepted, queuedResponse{
+			ID:    queued.ID,
+			State: queued.State,
+		})
+	})
 
-		logger.Info(message)
-		_ = discord.SendMessage(message)
+	mux.HandleFunc("/faucet/challenge", func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			writeResponse(writer, http.StatusMethodNotAllowed, errorResponse{
+				Error: "method not allowed",
+			})
+			return
+		}
+
+		challenge, err := faucet.challenger.issue()
+
+		if err != nil {
+			logger.Warning("Could not issue faucet challenge: " + err.Error())
+
+			writeResponse(writer, http.StatusServiceUnavailable, errorResponse{
+				Error: "could not issue challenge",
+			})
+			return
+		}
+
+		writeResponse(writer, http.StatusOK, challenge)
 	})
 
-	err := http.ListenAndServe("0.0.0.0:"+strconv.Itoa(faucet.Port), nil)
+	mux.HandleFunc("/faucet/", func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet {
+			writeResponse(writer, http.StatusMethodNotAllowed, errorResponse{
+				Error: "method not allowed",
+			})
+			return
+		}
 
-	if err != nil {
+		id := strings.TrimPrefix(request.URL.Path, "/faucet/")
+		queued, err := database.GetFaucetRequest(id)
+
+		if err != nil {
+			logger.Error("Could not get faucet request " + id + ": " + err.Error())
+
+			writeResponse(writer, http.StatusInternalServerError, errorResponse{
+				Error: "could not get request",
+			})
+			return
+		}
+
+		if queued == nil {
+			writeResponse(writer, http.StatusNotFound, errorResponse{
+				Error: "request not found",
+			})
+			return
+		}
+
+		writeResponse(writer, http.StatusOK, newRequestStatusResponse(queued))
+	})
+
+	server := &http.Server{
+		Addr:    "0.0.0.0:" + strconv.Itoa(faucet.Port),
+		Handler: mux,
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logger.Warning("Could not shut down faucet gracefully: " + err.Error())
+		}
+	}()
+
+	err = server.ListenAndServe()
+
+	if err != nil && err != http.ErrServerClosed {
 		logger.Fatal("Could not start faucet: " + err.Error())
 	}
+
+	faucet.workers.Wait()
+
+	logger.Info("Stopped faucet")
+}
+
+// Replaces the currencies that are sent to requesters
+func (faucet *Faucet) SetAssets(assets []Asset) {
+	var assetNames []string
+
+	for _, asset := range assets {
+		assetNames = append(assetNames, asset.Currency)
+	}
+
+	logger.Info("Faucet currencies: " + strings.Join(assetNames, ", "))
+
+	faucet.assetsLock.Lock()
+	defer faucet.assetsLock.Unlock()
+
+	faucet.assets = assets
+}
+
+// The returned slice must not be modified because it is shared with other callers
+func (faucet *Faucet) getAssets() []Asset {
+	faucet.assetsLock.RLock()
+	defer faucet.assetsLock.RUnlock()
+
+	return faucet.assets
 }
 
+// Replaces the configured captcha verifier; has to be called before the faucet is started
+func (faucet *Faucet) SetCaptchaVerifier(verifier CaptchaVerifier) {
+	faucet.captchaVerifier = verifier
+}
+
+// Rejects all requests for tokens until the faucet is resumed
+func (faucet *Faucet) Pause() {
+	atomic.StoreInt32(&faucet.paused, 1)
+	logger.Info("Paused faucet")
+}
+
+func (faucet *Faucet) Resume() {
+	atomic.StoreInt32(&faucet.paused, 0)
+	logger.Info("Resumed faucet")
+
+	faucet.wakeDispatcher()
+}
+
+func (faucet *Faucet) IsPaused() bool {
+	return atomic.LoadInt32(&faucet.paused) == 1
+}
+
+type sentTransaction struct {
+	currency    string
+	transaction *Transaction
+}
+
+// Tries to send all currencies even if some of the transfers fail
+// An error is returned only if not a single transfer succeeded
 func (faucet *Faucet) sendTokens(address string) (response faucetResponse, err error) {
+	if faucet.batcher != nil {
+		return faucet.sendBatchedTokens(address)
+	}
+
 	response.TokensSent = map[string]string{}
 
-	for _, channel := range faucet.channels {
-		amount := big.NewFloat(0.0)
-		amount = amount.Mul(decimals, big.NewFloat(channel.Amount))
+	var sent []sentTransaction
+	var firstError error
+
+	for _, asset := range faucet.getAssets() {
+		amount, transaction, sendError := faucet.sendAsset(asset, address)
+
+		result := transferResult{
+			Currency: asset.Currency,
+			Amount:   amount.String(),
+		}
+
+		if sendError != nil {
+			logger.Warning("Could not send " + asset.Currency + " to " + address + ": " + sendError.Error())
+
+			if firstError == nil {
+				firstError = sendError
+			}
+
+			result.Status = transferError
+			result.Error = sendError.Error()
+		} else {
+			response.TokensSent[asset.Currency] = amount.String()
+			metrics.TokensDispensed.WithLabelValues(asset.Currency).Add(asset.Amount)
+
+			result.Status = transferSuccess
+
+			if transaction != nil {
+				result.TransactionHash = transaction.Hash().String()
+
+				sent = append(sent, sentTransaction{
+					currency:    asset.Currency,
+					transaction: transaction,
+				})
+			}
+		}
+
+		response.Transfers = append(response.Transfers, result)
+	}
+
+	if len(sent) > 0 {
+		faucet.workers.Add(1)
+		go faucet.reportTransactions(address, sent)
+	} else if firstError != nil {
+		return response, firstError
+	}
+
+	return response, nil
+}
+
+// Returns the amount in the smallest unit of the asset
+func (faucet *Faucet) sendAsset(asset Asset, address string) (*big.Int, *Transaction, error) {
+	amount, err := asset.GetBaseAmount()
+
+	if err != nil {
+		return big.NewInt(0), nil, err
+	}
+
+	var transaction *Transaction
+
+	if !asset.IsValid() {
+		return amount, nil, errors.New("token address is not set")
+	}
 
-		stringAmount := big.NewInt(0)
-		stringAmount, _ = amount.Int(stringAmount)
+	if asset.IsEther() {
+		transaction, err = faucet.eth.SendEther(address, amount, asset.GasLimit)
+	} else {
+		transaction, err = faucet.eth.SendToken(asset.TokenAddress, address, amount.String(), asset.GasLimit)
+	}
+
+	return amount, transaction, err
+}
+
+func (response *faucetResponse) observe(err error) {
+	if err != nil {
+		metrics.FaucetRequests.WithLabelValues(metrics.FaucetFailed).Inc()
+		return
+	}
 
-		response.TokensSent[channel.Currency] = stringAmount.String()
+	if response.getFailedTransfers() != "" {
+		metrics.FaucetRequests.WithLabelValues(metrics.FaucetPartial).Inc()
+	} else {
+		metrics.FaucetRequests.WithLabelValues(metrics.FaucetSuccess).Inc()
+	}
+}
+
+// Returns a human readable list of the transfers that failed or an empty string if all succeeded
+func (response *faucetResponse) getFailedTransfers() string {
+	var failed []string
 
-		if channel.TokenAddress != "" {
-			err = faucet.eth.SendToken(channel.TokenAddress, address, stringAmount.String())
-		} else if channel.Currency == "ETH" {
-			err = faucet.eth.SendEther(address, stringAmount)
+	for _, transfer := range response.Transfers {
+		if transfer.Status == transferError {
+			failed = append(failed, transfer.Currency+" ("+transfer.Error+")")
 		}
+	}
+
+	return strings.Join(failed, ", ")
+}
+
+// Waits until all transactions are final and sends their status to Discord
+// Nothing is reported if the faucet is stopped before that
+func (faucet *Faucet) reportTransactions(address string, sent []sentTransaction) {
+	defer faucet.workers.Done()
+
+	var statuses []string
+	allMined := true
+
+	for _, entry := range sent {
+		status, err := entry.transaction.Wait(faucet.ctx)
 
 		if err != nil {
-			return response, err
+			return
+		}
+
+		if status != TransactionMined {
+			allMined = false
 		}
+
+		statuses = append(statuses, entry.currency+" "+string(status)+" (`"+entry.transaction.Hash().String()+"`)")
+	}
+
+	message := "Transactions to `" + address + "`: " + strings.Join(statuses, ", ")
+
+	if allMined {
+		logger.Info(message)
+	} else {
+		logger.Warning(message)
+	}
+
+	_ = faucet.discord.SendMessage(message)
+}
+
+func (faucet *Faucet) getClientIP(request *http.Request) string {
+	if faucet.TrustProxy && faucet.ProxyHops > 0 {
+		// Proxies can either append to an existing header or add another one
+		forwardedFor := strings.Join(request.Header.Values("X-Forwarded-For"), ",")
+
+		if forwardedFor != "" {
+			// Every trusted proxy appends the address it received the request from to the entries sent by the client
+			// The client can set any entries itself so only the ones that were appended by the proxies can be trusted
+			entries := strings.Split(forwardedFor, ",")
+			index := len(entries) - faucet.ProxyHops
+
+			// Requests that passed fewer proxies than configured contain only entries appended by trusted proxies
+			if index < 0 {
+				index = 0
+			}
+
+			return strings.TrimSpace(entries[index])
+		}
+	}
+
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+
+	if err != nil {
+		return request.RemoteAddr
 	}
 
-	return response, err
+	return host
 }
 
 func writeResponse(writer http.ResponseWriter, status int, data interface{}) {
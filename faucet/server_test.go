@@ -0,0 +1,47 @@
+package faucet
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetClientIP(t *testing.T) {
+	tests := []struct {
+		name         string
+		trustProxy   bool
+		proxyHops    int
+		forwardedFor []string
+		expected     string
+	}{
+		{"proxy not trusted", false, 1, []string{"10.0.0.1"}, "192.0.2.1"},
+		{"no header", true, 1, nil, "192.0.2.1"},
+		{"single proxy", true, 1, []string{"10.0.0.1"}, "10.0.0.1"},
+		{"spoofed entry", true, 1, []string{"10.0.0.9, 10.0.0.1"}, "10.0.0.1"},
+		{"two proxies", true, 2, []string{"10.0.0.9, 10.0.0.1, 10.0.0.2"}, "10.0.0.1"},
+		{"multiple headers", true, 1, []string{"10.0.0.9", "10.0.0.1"}, "10.0.0.1"},
+		{"multiple headers with two proxies", true, 2, []string{"10.0.0.9, 10.0.0.1", "10.0.0.2"}, "10.0.0.1"},
+		{"fewer entries than proxies", true, 3, []string{"10.0.0.1, 10.0.0.2"}, "10.0.0.1"},
+		{"zero hops", true, 0, []string{"10.0.0.1"}, "192.0.2.1"},
+		{"negative hops", true, -1, []string{"10.0.0.1"}, "192.0.2.1"},
+	}
+
+	for _, test := range tests {
+		faucet := Faucet{
+			TrustProxy: test.trustProxy,
+			ProxyHops:  test.proxyHops,
+		}
+
+		request := &http.Request{
+			Header:     http.Header{},
+			RemoteAddr: "192.0.2.1:1234",
+		}
+
+		for _, value := range test.forwardedFor {
+			request.Header.Add("X-Forwarded-For", value)
+		}
+
+		if ip := faucet.getClientIP(request); ip != test.expected {
+			t.Errorf("%s: getClientIP() = %s; expected %s", test.name, ip, test.expected)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package faucet
+
+import (
+	"context"
+	"errors"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/logger"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type TransactionStatus string
+
+const (
+	TransactionPending TransactionStatus = "pending"
+	// The transaction was included in a block and executed successfully
+	TransactionMined TransactionStatus = "mined"
+	// The transaction was included in a block but reverted
+	TransactionFailed TransactionStatus = "failed"
+	// The transaction was not included in a block even after it was replaced with higher gas prices
+	TransactionDropped TransactionStatus = "dropped"
+)
+
+// A transaction that is tracked until it is mined or dropped
+// Stuck transactions are replaced with the same nonce and a higher gas price, which changes their hash
+type Transaction struct {
+	Description string
+
+	lock sync.Mutex
+
+	transaction  *types.Transaction
+	hash         common.Hash
+	hashes       []common.Hash
+	broadcastAt  time.Time
+	replacements int
+
+	status TransactionStatus
+	done   chan struct{}
+}
+
+func newTransaction(description string, transaction *types.Transaction) *Transaction {
+	return &Transaction{
+		Description: description,
+		transaction: transaction,
+		hash:        transaction.Hash(),
+		hashes:      []common.Hash{transaction.Hash()},
+		broadcastAt: time.Now(),
+		status:      TransactionPending,
+		done:        make(chan struct{}),
+	}
+}
+
+// Returns the hash of the version of the transaction that was mined or the latest broadcast one
+func (transaction *Transaction) Hash() common.Hash {
+	transaction.lock.Lock()
+	defer transaction.lock.Unlock()
+
+	return transaction.hash
+}
+
+func (transaction *Transaction) Status() TransactionStatus {
+	transaction.lock.Lock()
+	defer transaction.lock.Unlock()
+
+	return transaction.status
+}
+
+// Blocks until the transaction was mined, failed or dropped or the context is done
+func (transaction *Transaction) Wait(ctx context.Context) (TransactionStatus, error) {
+	select {
+	case <-transaction.done:
+		return transaction.Status(), nil
+
+	case <-ctx.Done():
+		return transaction.Status(), ctx.Err()
+	}
+}
+
+func (transaction *Transaction) finish(status TransactionStatus, hash common.Hash) {
+	transaction.lock.Lock()
+	defer transaction.lock.Unlock()
+
+	if hash != (common.Hash{}) {
+		transaction.hash = hash
+	}
+
+	transaction.status = status
+	close(transaction.done)
+}
+
+// Signs and broadcasts a transaction with the next nonce of the account and starts tracking it
+// The gas is estimated if the gas limit is 0 and a contract is created if the recipient is nil
+// Has to be called with the sendLock held
+func (eth *Ethereum) sendTransaction(description string, to *common.Address, value *big.Int, gasLimit uint64, data []byte) (*Transaction, error) {
+	var err error
+
+	if gasLimit == 0 {
+		gasLimit, err = eth.estimateGas(to, value, data)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fees, err := eth.getGasFees()
+
+	if err != nil {
+		return nil, errors.New("could not get gas price: " + err.Error())
+	}
+
+	transaction := eth.buildTransaction(eth.nonce, to, value, gasLimit, fees, data)
+	transaction, err = eth.keystore.SignTx(eth.account, transaction, eth.chainID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("Sending " + description + ": " + transaction.Hash().String())
+
+	err = eth.client.SendTransaction(eth.ctx, transaction)
+
+	if err != nil {
+		// The nonce is read from the node again because the transaction might have been rejected
+		// after the node has seen it or a previous transaction could have been dropped
+		eth.resyncNonce()
+		return nil, err
+	}
+
+	eth.nonce += 1
+
+	tracked := newTransaction(description, transaction)
+
+	eth.transactionsLock.Lock()
+	eth.transactions = append(eth.transactions, tracked)
+	eth.transactionsLock.Unlock()
+
+	return tracked, nil
+}
+
+// Has to be called with the sendLock held
+func (eth *Ethereum) resyncNonce() {
+	nonce, err := eth.client.PendingNonceAt(eth.ctx, eth.account.Address)
+
+	if err != nil {
+		logger.Warning("Could not resync nonce: " + err.Error())
+		return
+	}
+
+	if nonce != eth.nonce {
+		logger.Info("Resynced nonce from " + strconv.FormatUint(eth.nonce, 10) + " to " + strconv.FormatUint(nonce, 10))
+	}
+
+	eth.nonce = nonce
+}
+
+func (eth *Ethereum) trackTransactions() {
+	ticker := time.NewTicker(time.Duration(eth.ReceiptInterval) * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-eth.ctx.Done():
+			return
+
+		case <-ticker.C:
+		}
+
+		eth.transactionsLock.Lock()
+		transactions := eth.transactions
+		eth.transactionsLock.Unlock()
+
+		var pending []*Transaction
+
+		for _, transaction := range transactions {
+			if eth.checkTransaction(transaction) {
+				pending = append(pending, transaction)
+			}
+		}
+
+		eth.transactionsLock.Lock()
+
+		// Transactions that were sent while checking the receipts were appended to the list
+		eth.transactions = append(pending, eth.transactions[len(transactions):]...)
+		eth.transactionsLock.Unlock()
+	}
+}
+
+// Returns whether the transaction is still pending
+func (eth *Ethereum) checkTransaction(transaction *Transaction) bool {
+	transaction.lock.Lock()
+	hashes := append([]common.Hash{}, transaction.hashes...)
+	broadcastAt := transaction.broadcastAt
+	replacements := transaction.replacements
+	transaction.lock.Unlock()
+
+	for _, hash := range hashes {
+		receipt, err := eth.client.TransactionReceipt(eth.ctx, hash)
+
+		if err != nil {
+			if err != ethereum.NotFound {
+				logger.Warning("Could not get receipt of transaction " + hash.String() + ": " + err.Error())
+			}
+
+			continue
+		}
+
+		status := TransactionMined
+
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			status = TransactionFailed
+		}
+
+		logger.Info("Transaction " + hash.String() + " of " + transaction.Description + " " + string(status))
+
+		transaction.finish(status, hash)
+		return false
+	}
+
+	if time.Since(broadcastAt) < time.Duration(eth.ReplaceTimeout)*time.Second {
+		return true
+	}
+
+	if replacements >= eth.MaxReplacements {
+		logger.Warning("Giving up on transaction of " + transaction.Description + " after " +
+			strconv.Itoa(replacements) + " replacements")
+
+		transaction.finish(TransactionDropped, common.Hash{})
+
+		sendLock.Lock()
+		eth.resyncNonce()
+		sendLock.Unlock()
+
+		return false
+	}
+
+	eth.replaceTransaction(transaction)
+	return true
+}
+
+// Broadcasts the transaction again with the same nonce and higher fees
+func (eth *Ethereum) replaceTransaction(transaction *Transaction) {
+	sendLock.Lock()
+	defer sendLock.Unlock()
+
+	transaction.lock.Lock()
+	defer transaction.lock.Unlock()
+
+	previous := transaction.transaction
+
+	// The timeout restarts even if the replacement fails to not retry on every tick
+	transaction.broadcastAt = time.Now()
+	transaction.replacements += 1
+
+	fees, err := eth.bumpGasFees(previous)
+
+	if err != nil {
+		logger.Warning("Could not replace stuck transaction " + previous.Hash().String() + ": " + err.Error())
+		return
+	}
+
+	replacement := eth.buildTransaction(previous.Nonce(), previous.To(), previous.Value(), previous.Gas(), fees, previous.Data())
+	replacement, err = eth.keystore.SignTx(eth.account, replacement, eth.chainID)
+
+	if err != nil {
+		logger.Warning("Could not sign replacement of transaction " + previous.Hash().String() + ": " + err.Error())
+		return
+	}
+
+	logger.Info("Replacing stuck transaction " + previous.Hash().String() + " of " + transaction.Description +
+		" with " + replacement.Hash().String() + " and " + fees.String())
+
+	err = eth.client.SendTransaction(eth.ctx, replacement)
+
+	if err != nil {
+		// Will fail with "nonce too low" if one of the previous versions was mined in the meantime
+		logger.Warning("Could not send replacement of transaction " + previous.Hash().String() + ": " + err.Error())
+		return
+	}
+
+	transaction.transaction = replacement
+	transaction.hash = replacement.Hash()
+	transaction.hashes = append(transaction.hashes, replacement.Hash())
+}
@@ -27,5 +27,12 @@ func printFatal(format string, a ...interface{}) {
 }
 
 func logConfig(cfg *config) {
-	logger.Info("Loaded config: " + stringify(cfg))
+	redacted, err := cfg.redacted()
+
+	if err != nil {
+		logger.Warning("Could not redact config: " + err.Error())
+		return
+	}
+
+	logger.Info("Loaded config: " + stringify(redacted))
 }
@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"github.com/google/logger"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type GaugeValue struct {
+	// Values of the labels in the order in which the label names were registered
+	Labels []string
+	Value  float64
+}
+
+// Gauge whose values are queried every time the metrics are scraped
+type queriedGauge struct {
+	description *prometheus.Desc
+	query       func() ([]GaugeValue, error)
+}
+
+// Registers a gauge whose values are queried on every scrape
+// Errors of queries are logged and the values that could be queried are still exposed
+func RegisterQueriedGauge(name string, help string, labelNames []string, query func() ([]GaugeValue, error)) {
+	prometheus.MustRegister(&queriedGauge{
+		description: prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, labelNames, nil),
+		query:       query,
+	})
+}
+
+func (gauge *queriedGauge) Describe(descriptions chan<- *prometheus.Desc) {
+	descriptions <- gauge.description
+}
+
+func (gauge *queriedGauge) Collect(metrics chan<- prometheus.Metric) {
+	values, err := gauge.query()
+
+	if err != nil {
+		logger.Warning("Could not query values of " + gauge.description.String() + ": " + err.Error())
+	}
+
+	for _, value := range values {
+		metrics <- prometheus.MustNewConstMetric(gauge.description, prometheus.GaugeValue, value.Value, value.Labels...)
+	}
+}
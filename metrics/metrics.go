@@ -0,0 +1,108 @@
+package metrics
+
+import (
+	"context"
+	"github.com/google/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const namespace = "xud_simnet_bot"
+
+var (
+	FaucetRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "faucet_requests_total",
+		Help:      "Number of faucet requests by outcome",
+	}, []string{"outcome"})
+
+	TokensDispensed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "faucet_tokens_dispensed_total",
+		Help:      "Amount of tokens the faucet sent per currency",
+	}, []string{"currency"})
+
+	ChannelsOpened = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "channels_opened_total",
+		Help:      "Number of channels that were opened per currency",
+	}, []string{"currency"})
+
+	ChannelsFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "channels_failed_total",
+		Help:      "Number of channels that could not be opened per currency",
+	}, []string{"currency"})
+
+	XudCallDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "xud_call_duration_seconds",
+		Help:      "Latency of calls to the XUD gRPC interface per method",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	XudCallErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "xud_call_errors_total",
+		Help:      "Number of failed calls to the XUD gRPC interface per method",
+	}, []string{"method"})
+
+	DiscordSendFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "discord_send_failures_total",
+		Help:      "Number of messages that could not be sent to Discord",
+	})
+)
+
+// Outcomes of faucet requests
+const (
+	FaucetSuccess         = "success"
+	FaucetPartial         = "partial"
+	FaucetFailed          = "failed"
+	FaucetRateLimited     = "rate_limited"
+	FaucetInvalid         = "invalid"
+	FaucetPaused          = "paused"
+	FaucetQueueFull       = "queue_full"
+	FaucetChallengeFailed = "challenge_failed"
+)
+
+type Metrics struct {
+	Port int `long:"metrics.port" description:"Port to which the HTTP server exposing Prometheus metrics will listen; 0 disables it"`
+}
+
+// Serves the metrics until the context is done
+func (metrics *Metrics) Start(ctx context.Context) {
+	if metrics.Port == 0 {
+		logger.Info("Metrics are disabled")
+		return
+	}
+
+	logger.Info("Starting metrics server at port: " + strconv.Itoa(metrics.Port))
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	server := &http.Server{
+		Addr:    "0.0.0.0:" + strconv.Itoa(metrics.Port),
+		Handler: mux,
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	err := server.ListenAndServe()
+
+	if err != nil && err != http.ErrServerClosed {
+		logger.Fatal("Could not start metrics server: " + err.Error())
+	}
+}
@@ -0,0 +1,148 @@
+package monitor
+
+import (
+	"context"
+	"github.com/ExchangeUnion/xud-simnet-bot/channels"
+	"github.com/ExchangeUnion/xud-simnet-bot/discord"
+	"github.com/ExchangeUnion/xud-simnet-bot/faucet"
+	"github.com/ExchangeUnion/xud-simnet-bot/xudrpc"
+	"github.com/google/logger"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Warns on Discord when the faucet wallet or the wallets of the XUD node run low on funds
+type BalanceMonitor struct {
+	Interval int `long:"monitor.interval" default:"300" description:"Interval in seconds at which the balances of the faucet and the XUD node should be checked"`
+
+	faucetAssets   []faucet.Asset
+	nodeChannels   []channels.Channel
+	currenciesLock sync.RWMutex
+
+	eth     *faucet.Ethereum
+	xud     *xudrpc.Xud
+	discord *discord.Discord
+
+	// Set of the wallets whose balance is below their threshold
+	// Warnings are only sent when a balance crosses its threshold and not on every check
+	lowBalances map[string]bool
+}
+
+// Checks the balances of the currencies that were set until the context is done
+func (monitor *BalanceMonitor) Start(
+	ctx context.Context,
+	eth *faucet.Ethereum,
+	xud *xudrpc.Xud,
+	discord *discord.Discord,
+) {
+	logger.Info("Starting balance monitor")
+
+	monitor.eth = eth
+	monitor.xud = xud
+	monitor.discord = discord
+
+	monitor.lowBalances = map[string]bool{}
+
+	ticker := time.NewTicker(time.Duration(monitor.Interval) * time.Second)
+	defer ticker.Stop()
+
+	monitor.checkBalances()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Stopped balance monitor")
+			return
+
+		case <-ticker.C:
+			monitor.checkBalances()
+		}
+	}
+}
+
+// Replaces the currencies whose balances are checked
+func (monitor *BalanceMonitor) SetCurrencies(faucetAssets []faucet.Asset, nodeChannels []channels.Channel) {
+	monitor.currenciesLock.Lock()
+	defer monitor.currenciesLock.Unlock()
+
+	monitor.faucetAssets = faucetAssets
+	monitor.nodeChannels = nodeChannels
+}
+
+func (monitor *BalanceMonitor) checkBalances() {
+	monitor.currenciesLock.RLock()
+	faucetAssets := monitor.faucetAssets
+	nodeChannels := monitor.nodeChannels
+	monitor.currenciesLock.RUnlock()
+
+	for _, asset := range faucetAssets {
+		if asset.MinBalance == 0 {
+			continue
+		}
+
+		balance, err := monitor.eth.GetAssetBalance(asset)
+
+		if err != nil {
+			logger.Warning("Could not get " + asset.Currency + " balance of faucet: " + err.Error())
+			continue
+		}
+
+		monitor.checkBalance("faucet", asset.Currency, balance, asset.MinBalance)
+	}
+
+	if len(nodeChannels) == 0 {
+		return
+	}
+
+	balances, err := monitor.xud.GetBalance("")
+
+	if err != nil {
+		logger.Warning("Could not get balances of XUD: " + err.Error())
+		return
+	}
+
+	for _, channel := range nodeChannels {
+		if channel.MinBalance == 0 {
+			continue
+		}
+
+		balance, ok := balances.Balances[channel.Currency]
+
+		if !ok {
+			logger.Warning("XUD did not return a balance for " + channel.Currency)
+			continue
+		}
+
+		// Channels are funded with the confirmed wallet balance
+		monitor.checkBalance("XUD", channel.Currency, float64(balance.WalletBalance)/1e8, channel.MinBalance)
+	}
+}
+
+func (monitor *BalanceMonitor) checkBalance(wallet string, currency string, balance float64, threshold float64) {
+	key := wallet + ":" + currency
+	wasLow := monitor.lowBalances[key]
+	isLow := balance < threshold
+
+	if isLow == wasLow {
+		return
+	}
+
+	monitor.lowBalances[key] = isLow
+
+	formattedBalance := strconv.FormatFloat(balance, 'f', -1, 64) + " " + currency
+	formattedThreshold := strconv.FormatFloat(threshold, 'f', -1, 64) + " " + currency
+
+	if isLow {
+		message := ":warning: " + currency + " balance of the " + wallet + " wallet is running low: " +
+			formattedBalance + " (threshold: " + formattedThreshold + ")"
+
+		logger.Warning(message)
+		_ = monitor.discord.SendMessage(message)
+	} else {
+		message := currency + " balance of the " + wallet + " wallet is above its threshold again: " + formattedBalance
+
+		logger.Info(message)
+		_ = monitor.discord.SendMessage(message)
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"github.com/BurntSushi/toml"
+	"github.com/google/logger"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// Reloads the channels and faucet assets of the config file on SIGHUP or when the file changes until the context is done
+// All other options require a restart
+func watchConfig(ctx context.Context, cfg *config) {
+	if cfg.ConfigFile == "" {
+		return
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	defer signal.Stop(signals)
+
+	var ticks <-chan time.Time
+
+	if cfg.ReloadInterval > 0 {
+		ticker := time.NewTicker(time.Duration(cfg.ReloadInterval) * time.Second)
+		defer ticker.Stop()
+
+		ticks = ticker.C
+	}
+
+	lastModified := getModificationTime(cfg.ConfigFile)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-signals:
+			logger.Info("Received SIGHUP; reloading config")
+
+			lastModified = getModificationTime(cfg.ConfigFile)
+			reloadConfig(cfg)
+
+		case <-ticks:
+			modified := getModificationTime(cfg.ConfigFile)
+
+			if !modified.Equal(lastModified) {
+				logger.Info("Config file changed; reloading config")
+
+				lastModified = modified
+				reloadConfig(cfg)
+			}
+		}
+	}
+}
+
+// The current config is kept if the new one is invalid
+func reloadConfig(cfg *config) {
+	var newCfg config
+
+	if _, err := toml.DecodeFile(cfg.ConfigFile, &newCfg); err != nil {
+		reportReloadError(cfg, err)
+		return
+	}
+
+	currencies := newCfg.getCurrencyConfig()
+
+	if err := validateCurrencyConfig(currencies, cfg.Xud, cfg.Ethereum); err != nil {
+		reportReloadError(cfg, err)
+		return
+	}
+
+	if err := currencies.resolveDecimals(cfg.Xud, cfg.Ethereum); err != nil {
+		reportReloadError(cfg, err)
+		return
+	}
+
+	currencies.apply(cfg)
+
+	cfg.lock.Lock()
+	cfg.LightningChannels = newCfg.LightningChannels
+	cfg.RaidenChannels = newCfg.RaidenChannels
+	cfg.FaucetAssets = newCfg.FaucetAssets
+	cfg.Channels = newCfg.Channels
+	cfg.lock.Unlock()
+
+	message := "Reloaded config with " + currencies.String()
+
+	logger.Info(message)
+	_ = cfg.Discord.SendMessage(message)
+}
+
+func reportReloadError(cfg *config, err error) {
+	message := "Could not reload config: " + err.Error()
+
+	logger.Warning(message)
+	_ = cfg.Discord.SendMessage(message)
+}
+
+// Returns the zero time if the file cannot be accessed
+func getModificationTime(fileName string) time.Time {
+	info, err := os.Stat(fileName)
+
+	if err != nil {
+		return time.Time{}
+	}
+
+	return info.ModTime()
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"errors"
+	"github.com/ExchangeUnion/xud-simnet-bot/faucet"
+	"github.com/ExchangeUnion/xud-simnet-bot/xudrpc"
+	"github.com/ethereum/go-ethereum/common"
+	"strconv"
+	"strings"
+)
+
+// Collects all problems of the config so that they can be fixed at once
+type validationReport struct {
+	problems []string
+}
+
+type namedAmount struct {
+	name  string
+	value float64
+}
+
+func (report *validationReport) add(problem string) {
+	report.problems = append(report.problems, problem)
+}
+
+func (report *validationReport) err(subject string) error {
+	if len(report.problems) == 0 {
+		return nil
+	}
+
+	return errors.New("invalid " + subject + ":\n- " + strings.Join(report.problems, "\n- "))
+}
+
+// Checks the options with which the services could not run at all
+func validateOptions(cfg *config) error {
+	report := &validationReport{}
+
+	report.checkInterval("manager.interval", cfg.ChannelManager.Interval)
+	report.checkInterval("manager.reapinterval", cfg.ChannelManager.ReapInterval)
+	report.checkInterval("monitor.interval", cfg.BalanceMonitor.Interval)
+	report.checkInterval("eth.receiptinterval", cfg.Ethereum.ReceiptInterval)
+
+	return report.err("options")
+}
+
+func (report *validationReport) checkInterval(name string, seconds int) {
+	if seconds <= 0 {
+		report.add(name + " has to be positive but is " + strconv.Itoa(seconds))
+	}
+}
+
+// Checks the currencies themselves, whether XUD supports them and whether their token contracts exist
+func validateCurrencyConfig(currencies *currencyConfig, xud *xudrpc.Xud, eth *faucet.Ethereum) error {
+	report := &validationReport{}
+
+	lightningNames := map[string]bool{}
+
+	for i, channel := range currencies.lightningChannels {
+		name := report.checkCurrency("lightning channel", i, channel.Currency, lightningNames)
+
+		report.checkAmounts(name, []namedAmount{
+			{"Amount", channel.Amount},
+			{"PushAmount", channel.PushAmount},
+			{"MinBalance", channel.MinBalance},
+			{"PreferredAmount", channel.PreferredAmount},
+			{"PreferredPushAmount", channel.PreferredPushAmount},
+			{"MinAmount", channel.MinAmount},
+			{"ReferenceRate", channel.ReferenceRate},
+		})
+
+		if channel.PushAmount > channel.Amount {
+			report.add(name + ": PushAmount must not be greater than Amount")
+		}
+
+		if channel.PreferredAmount != 0 && channel.PreferredPushAmount > channel.PreferredAmount {
+			report.add(name + ": PreferredPushAmount must not be greater than PreferredAmount")
+		}
+	}
+
+	raidenNames := map[string]bool{}
+
+	for i, channel := range currencies.raidenChannels {
+		name := report.checkCurrency("Raiden channel", i, channel.Currency, raidenNames)
+
+		report.checkAmounts(name, []namedAmount{
+			{"Amount", channel.Amount},
+			{"MinBalance", channel.MinBalance},
+			{"PreferredAmount", channel.PreferredAmount},
+			{"MinAmount", channel.MinAmount},
+			{"ReferenceRate", channel.ReferenceRate},
+		})
+	}
+
+	assetNames := map[string]bool{}
+
+	for i, asset := range currencies.faucetAssets {
+		name := report.checkCurrency("faucet asset", i, asset.Currency, assetNames)
+
+		report.checkAmounts(name, []namedAmount{
+			{"Amount", asset.Amount},
+			{"MinBalance", asset.MinBalance},
+		})
+
+		report.checkTokenAddress(name, asset.TokenAddress)
+
+		if !asset.IsValid() {
+			report.add(name + ": TokenAddress is not set; only " + faucet.EtherCurrency + " is sent as Ether")
+		}
+
+		if asset.IsEther() && asset.Decimals != 0 && asset.Decimals != 18 {
+			report.add(name + ": Ether always has 18 decimals")
+		}
+	}
+
+	// Checking against XUD and the chain makes no sense if the currencies themselves are invalid
+	if len(report.problems) == 0 {
+		report.checkXudCurrencies(currencies, xud)
+		report.checkContracts(currencies.faucetAssets, eth)
+	}
+
+	return report.err("currencies")
+}
+
+// Returns the name of the entry that is used in the report
+func (report *validationReport) checkCurrency(kind string, index int, currency string, seen map[string]bool) string {
+	if currency == "" {
+		name := kind + " #" + strconv.Itoa(index+1)
+		report.add(name + ": currency is not set")
+
+		return name
+	}
+
+	name := kind + " " + currency
+
+	if seen[currency] {
+		report.add(name + ": currency is configured multiple times")
+	}
+
+	seen[currency] = true
+
+	return name
+}
+
+func (report *validationReport) checkAmounts(name string, amounts []namedAmount) {
+	for _, amount := range amounts {
+		if amount.value < 0 {
+			report.add(name + ": " + amount.name + " must not be negative")
+		}
+	}
+}
+
+func (report *validationReport) checkTokenAddress(name string, tokenAddress string) {
+	if tokenAddress == "" {
+		return
+	}
+
+	if !common.IsHexAddress(tokenAddress) {
+		report.add(name + ": TokenAddress is not a valid address: " + tokenAddress)
+		return
+	}
+
+	// Without the checksum a typo would result in a different but valid address
+	if checksummed := common.HexToAddress(tokenAddress).Hex(); tokenAddress != checksummed {
+		report.add(name + ": TokenAddress is not checksummed; did you mean " + checksummed + "?")
+	}
+}
+
+func (report *validationReport) checkXudCurrencies(currencies *currencyConfig, xud *xudrpc.Xud) {
+	response, err := xud.ListCurrencies()
+
+	if err != nil {
+		report.add("could not get currencies of XUD: " + err.Error())
+		return
+	}
+
+	supported := map[string]*xudrpc.Currency{}
+
+	for _, currency := range response.Currencies {
+		supported[currency.Currency] = currency
+	}
+
+	checkSwapClient := func(name string, currency string, swapClient xudrpc.Currency_SwapClient) {
+		if xudCurrency, ok := supported[currency]; !ok {
+			report.add(name + ": currency is not supported by XUD")
+		} else if xudCurrency.SwapClient != swapClient {
+			report.add(name + ": XUD uses " + xudCurrency.SwapClient.String() + " for the currency")
+		}
+	}
+
+	for _, channel := range currencies.lightningChannels {
+		checkSwapClient("lightning channel "+channel.Currency, channel.Currency, xudrpc.Currency_LND)
+	}
+
+	for _, channel := range currencies.raidenChannels {
+		checkSwapClient("Raiden channel "+channel.Currency, channel.Currency, xudrpc.Currency_RAIDEN)
+	}
+
+	for _, asset := range currencies.faucetAssets {
+		xudCurrency, ok := supported[asset.Currency]
+
+		if !ok {
+			report.add("faucet asset " + asset.Currency + ": currency is not supported by XUD")
+			continue
+		}
+
+		if !asset.IsEther() && xudCurrency.TokenAddress != "" && !strings.EqualFold(asset.TokenAddress, xudCurrency.TokenAddress) {
+			report.add("faucet asset " + asset.Currency + ": TokenAddress differs from the one of XUD: " + xudCurrency.TokenAddress)
+		}
+	}
+}
+
+func (report *validationReport) checkContracts(assets []faucet.Asset, eth *faucet.Ethereum) {
+	for _, asset := range assets {
+		if asset.IsEther() {
+			continue
+		}
+
+		isContract, err := eth.IsContract(asset.TokenAddress)
+
+		if err != nil {
+			report.add("faucet asset " + asset.Currency + ": could not check token contract: " + err.Error())
+		} else if !isContract {
+			report.add("faucet asset " + asset.Currency + ": no contract is deployed at TokenAddress " + asset.TokenAddress)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package xudrpc
+
+import (
+	"context"
+	"github.com/ExchangeUnion/xud-simnet-bot/metrics"
+	"google.golang.org/grpc"
+	"path"
+	"time"
+)
+
+func (xud *Xud) registerMetrics() {
+	metrics.RegisterQueriedGauge(
+		"xud_balance",
+		"Balances of the XUD node per currency in whole coins",
+		[]string{"currency", "type"},
+		xud.queryBalances,
+	)
+}
+
+func (xud *Xud) queryBalances() ([]metrics.GaugeValue, error) {
+	response, err := xud.GetBalance("")
+
+	if err != nil {
+		return nil, err
+	}
+
+	var values []metrics.GaugeValue
+
+	for currency, balance := range response.Balances {
+		for balanceType, satoshis := range map[string]uint64{
+			"channel":            balance.ChannelBalance,
+			"pending_channel":    balance.PendingChannelBalance,
+			"inactive_channel":   balance.InactiveChannelBalance,
+			"wallet":             balance.WalletBalance,
+			"unconfirmed_wallet": balance.UnconfirmedWalletBalance,
+		} {
+			values = append(values, metrics.GaugeValue{
+				Labels: []string{currency, balanceType},
+				Value:  float64(satoshis) / 1e8,
+			})
+		}
+	}
+
+	return values, nil
+}
+
+// Records the latency and errors of every call to XUD
+func observeCall(ctx context.Context, method string, request, reply interface{}, conn *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, options ...grpc.CallOption) error {
+
+	start := time.Now()
+	err := invoker(ctx, method, request, reply, conn, options...)
+
+	name := path.Base(method)
+	metrics.XudCallDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		metrics.XudCallErrors.WithLabelValues(name).Inc()
+	}
+
+	return err
+}
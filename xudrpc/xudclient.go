@@ -5,6 +5,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"strconv"
+	"time"
 )
 
 type Xud struct {
@@ -14,38 +15,82 @@ type Xud struct {
 	Certificate string `long:"xud.certificatepath" description:"Path to the certificate of the XUD gRPC interface"`
 
 	ctx    context.Context
+	conn   *grpc.ClientConn
 	client XudClient
 }
 
-func (xud *Xud) Init() error {
+// All calls of the client are canceled once the context is done
+func (xud *Xud) Init(ctx context.Context) error {
 	creds, err := credentials.NewClientTLSFromFile(xud.Certificate, "")
 
 	if err != nil {
 		return err
 	}
 
-	con, err := grpc.Dial(xud.Host+":"+strconv.Itoa(xud.Port), grpc.WithTransportCredentials(creds))
+	xud.conn, err = grpc.Dial(
+		xud.Host+":"+strconv.Itoa(xud.Port),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUnaryInterceptor(observeCall),
+	)
 
 	if err != nil {
 		return err
 	}
 
-	if xud.ctx == nil {
-		xud.ctx = context.Background()
-	}
+	xud.ctx = ctx
+	xud.client = NewXudClient(xud.conn)
+	xud.registerMetrics()
 
-	xud.client = NewXudClient(con)
 	return nil
 }
 
+func (xud *Xud) Close() error {
+	return xud.conn.Close()
+}
+
 func (xud *Xud) GetInfo() (*GetInfoResponse, error) {
 	return xud.client.GetInfo(xud.ctx, &GetInfoRequest{})
 }
 
+func (xud *Xud) GetBalance(currency string) (*GetBalanceResponse, error) {
+	return xud.client.GetBalance(xud.ctx, &GetBalanceRequest{
+		Currency: currency,
+	})
+}
+
+func (xud *Xud) ListCurrencies() (*ListCurrenciesResponse, error) {
+	return xud.client.ListCurrencies(xud.ctx, &ListCurrenciesRequest{})
+}
+
 func (xud *Xud) ListPeers() (*ListPeersResponse, error) {
 	return xud.client.ListPeers(xud.ctx, &ListPeersRequest{})
 }
 
-func (xud *Xud) OpenChannel(request *OpenChannelRequest) (*OpenChannelResponse, error) {
-	return xud.client.OpenChannel(xud.ctx, request)
+func (xud *Xud) TradingLimits(currency string) (*TradingLimitsResponse, error) {
+	return xud.client.TradingLimits(xud.ctx, &TradingLimitsRequest{
+		Currency: currency,
+	})
+}
+
+// Streams orders that are added to or removed from the order book until the context is done
+func (xud *Xud) SubscribeOrders() (Xud_SubscribeOrdersClient, error) {
+	return xud.client.SubscribeOrders(xud.ctx, &SubscribeOrdersRequest{
+		Existing: false,
+	})
+}
+
+// Closes the channels to the node in the currency; the call is canceled if it takes longer than the timeout
+func (xud *Xud) CloseChannel(request *CloseChannelRequest, timeout time.Duration) (*CloseChannelResponse, error) {
+	ctx, cancel := context.WithTimeout(xud.ctx, timeout)
+	defer cancel()
+
+	return xud.client.CloseChannel(ctx, request)
+}
+
+// The call is canceled if it takes longer than the timeout
+func (xud *Xud) OpenChannel(request *OpenChannelRequest, timeout time.Duration) (*OpenChannelResponse, error) {
+	ctx, cancel := context.WithTimeout(xud.ctx, timeout)
+	defer cancel()
+
+	return xud.client.OpenChannel(ctx, request)
 }
@@ -70,7 +70,7 @@ func (x Currency_SwapClient) String() string {
 }
 
 func (Currency_SwapClient) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{12, 0}
+	return fileDescriptor_6960a02cc0a63cf6, []int{14, 0}
 }
 
 type ListOrdersRequest_Owner int32
@@ -98,7 +98,7 @@ func (x ListOrdersRequest_Owner) String() string {
 }
 
 func (ListOrdersRequest_Owner) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{24, 0}
+	return fileDescriptor_6960a02cc0a63cf6, []int{26, 0}
 }
 
 type SwapSuccess_Role int32
@@ -123,7 +123,7 @@ func (x SwapSuccess_Role) String() string {
 }
 
 func (SwapSuccess_Role) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{58, 0}
+	return fileDescriptor_6960a02cc0a63cf6, []int{60, 0}
 }
 
 type AddCurrencyResponse struct {
@@ -509,6 +509,95 @@ func (m *Channels) GetClosed() uint32 {
 	return 0
 }
 
+type CloseChannelRequest struct {
+	// The node pub key or alias of the peer with which to close any channels with.
+	NodeIdentifier string `protobuf:"bytes,1,opt,name=node_identifier,proto3" json:"node_identifier,omitempty"`
+	// The ticker symbol of the currency of the channel to close.
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// Whether to force close the channel in case the counterparty is offline or unresponsive.
+	Force                bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseChannelRequest) Reset()         { *m = CloseChannelRequest{} }
+func (m *CloseChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*CloseChannelRequest) ProtoMessage()    {}
+func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6960a02cc0a63cf6, []int{8}
+}
+
+func (m *CloseChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseChannelRequest.Unmarshal(m, b)
+}
+func (m *CloseChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseChannelRequest.Marshal(b, m, deterministic)
+}
+func (m *CloseChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseChannelRequest.Merge(m, src)
+}
+func (m *CloseChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_CloseChannelRequest.Size(m)
+}
+func (m *CloseChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseChannelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseChannelRequest proto.InternalMessageInfo
+
+func (m *CloseChannelRequest) GetNodeIdentifier() string {
+	if m != nil {
+		return m.NodeIdentifier
+	}
+	return ""
+}
+
+func (m *CloseChannelRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *CloseChannelRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type CloseChannelResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseChannelResponse) Reset()         { *m = CloseChannelResponse{} }
+func (m *CloseChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*CloseChannelResponse) ProtoMessage()    {}
+func (*CloseChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6960a02cc0a63cf6, []int{9}
+}
+
+func (m *CloseChannelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseChannelResponse.Unmarshal(m, b)
+}
+func (m *CloseChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseChannelResponse.Marshal(b, m, deterministic)
+}
+func (m *CloseChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseChannelResponse.Merge(m, src)
+}
+func (m *CloseChannelResponse) XXX_Size() int {
+	return xxx_messageInfo_CloseChannelResponse.Size(m)
+}
+func (m *CloseChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseChannelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseChannelResponse proto.InternalMessageInfo
+
 type ConnectRequest struct {
 	// The uri of the node to connect to in "[nodePubKey]@[host]:[port]" format.
 	NodeUri              string   `protobuf:"bytes,1,opt,name=node_uri,proto3" json:"node_uri,omitempty"`
@@ -521,7 +610,7 @@ func (m *ConnectRequest) Reset()         { *m = ConnectRequest{} }
 func (m *ConnectRequest) String() string { return proto.CompactTextString(m) }
 func (*ConnectRequest) ProtoMessage()    {}
 func (*ConnectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{8}
+	return fileDescriptor_6960a02cc0a63cf6, []int{10}
 }
 
 func (m *ConnectRequest) XXX_Unmarshal(b []byte) error {
@@ -559,7 +648,7 @@ func (m *ConnectResponse) Reset()         { *m = ConnectResponse{} }
 func (m *ConnectResponse) String() string { return proto.CompactTextString(m) }
 func (*ConnectResponse) ProtoMessage()    {}
 func (*ConnectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{9}
+	return fileDescriptor_6960a02cc0a63cf6, []int{11}
 }
 
 func (m *ConnectResponse) XXX_Unmarshal(b []byte) error {
@@ -593,7 +682,7 @@ func (m *CreateNodeRequest) Reset()         { *m = CreateNodeRequest{} }
 func (m *CreateNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateNodeRequest) ProtoMessage()    {}
 func (*CreateNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{10}
+	return fileDescriptor_6960a02cc0a63cf6, []int{12}
 }
 
 func (m *CreateNodeRequest) XXX_Unmarshal(b []byte) error {
@@ -637,7 +726,7 @@ func (m *CreateNodeResponse) Reset()         { *m = CreateNodeResponse{} }
 func (m *CreateNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateNodeResponse) ProtoMessage()    {}
 func (*CreateNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{11}
+	return fileDescriptor_6960a02cc0a63cf6, []int{13}
 }
 
 func (m *CreateNodeResponse) XXX_Unmarshal(b []byte) error {
@@ -701,7 +790,7 @@ func (m *Currency) Reset()         { *m = Currency{} }
 func (m *Currency) String() string { return proto.CompactTextString(m) }
 func (*Currency) ProtoMessage()    {}
 func (*Currency) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{12}
+	return fileDescriptor_6960a02cc0a63cf6, []int{14}
 }
 
 func (m *Currency) XXX_Unmarshal(b []byte) error {
@@ -762,7 +851,7 @@ func (m *DiscoverNodesRequest) Reset()         { *m = DiscoverNodesRequest{} }
 func (m *DiscoverNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*DiscoverNodesRequest) ProtoMessage()    {}
 func (*DiscoverNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{13}
+	return fileDescriptor_6960a02cc0a63cf6, []int{15}
 }
 
 func (m *DiscoverNodesRequest) XXX_Unmarshal(b []byte) error {
@@ -801,7 +890,7 @@ func (m *DiscoverNodesResponse) Reset()         { *m = DiscoverNodesResponse{} }
 func (m *DiscoverNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*DiscoverNodesResponse) ProtoMessage()    {}
 func (*DiscoverNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{14}
+	return fileDescriptor_6960a02cc0a63cf6, []int{16}
 }
 
 func (m *DiscoverNodesResponse) XXX_Unmarshal(b []byte) error {
@@ -847,7 +936,7 @@ func (m *ExecuteSwapRequest) Reset()         { *m = ExecuteSwapRequest{} }
 func (m *ExecuteSwapRequest) String() string { return proto.CompactTextString(m) }
 func (*ExecuteSwapRequest) ProtoMessage()    {}
 func (*ExecuteSwapRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{15}
+	return fileDescriptor_6960a02cc0a63cf6, []int{17}
 }
 
 func (m *ExecuteSwapRequest) XXX_Unmarshal(b []byte) error {
@@ -909,7 +998,7 @@ func (m *GetBalanceRequest) Reset()         { *m = GetBalanceRequest{} }
 func (m *GetBalanceRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBalanceRequest) ProtoMessage()    {}
 func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{16}
+	return fileDescriptor_6960a02cc0a63cf6, []int{18}
 }
 
 func (m *GetBalanceRequest) XXX_Unmarshal(b []byte) error {
@@ -949,7 +1038,7 @@ func (m *GetBalanceResponse) Reset()         { *m = GetBalanceResponse{} }
 func (m *GetBalanceResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBalanceResponse) ProtoMessage()    {}
 func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{17}
+	return fileDescriptor_6960a02cc0a63cf6, []int{19}
 }
 
 func (m *GetBalanceResponse) XXX_Unmarshal(b []byte) error {
@@ -987,7 +1076,7 @@ func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
 func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetInfoRequest) ProtoMessage()    {}
 func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{18}
+	return fileDescriptor_6960a02cc0a63cf6, []int{20}
 }
 
 func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -1037,7 +1126,7 @@ func (m *GetInfoResponse) Reset()         { *m = GetInfoResponse{} }
 func (m *GetInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetInfoResponse) ProtoMessage()    {}
 func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{19}
+	return fileDescriptor_6960a02cc0a63cf6, []int{21}
 }
 
 func (m *GetInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -1147,7 +1236,7 @@ func (m *GetNodeInfoRequest) Reset()         { *m = GetNodeInfoRequest{} }
 func (m *GetNodeInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetNodeInfoRequest) ProtoMessage()    {}
 func (*GetNodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{20}
+	return fileDescriptor_6960a02cc0a63cf6, []int{22}
 }
 
 func (m *GetNodeInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -1190,7 +1279,7 @@ func (m *GetNodeInfoResponse) Reset()         { *m = GetNodeInfoResponse{} }
 func (m *GetNodeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetNodeInfoResponse) ProtoMessage()    {}
 func (*GetNodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{21}
+	return fileDescriptor_6960a02cc0a63cf6, []int{23}
 }
 
 func (m *GetNodeInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -1235,7 +1324,7 @@ func (m *ListCurrenciesRequest) Reset()         { *m = ListCurrenciesRequest{} }
 func (m *ListCurrenciesRequest) String() string { return proto.CompactTextString(m) }
 func (*ListCurrenciesRequest) ProtoMessage()    {}
 func (*ListCurrenciesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{22}
+	return fileDescriptor_6960a02cc0a63cf6, []int{24}
 }
 
 func (m *ListCurrenciesRequest) XXX_Unmarshal(b []byte) error {
@@ -1268,7 +1357,7 @@ func (m *ListCurrenciesResponse) Reset()         { *m = ListCurrenciesResponse{}
 func (m *ListCurrenciesResponse) String() string { return proto.CompactTextString(m) }
 func (*ListCurrenciesResponse) ProtoMessage()    {}
 func (*ListCurrenciesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{23}
+	return fileDescriptor_6960a02cc0a63cf6, []int{25}
 }
 
 func (m *ListCurrenciesResponse) XXX_Unmarshal(b []byte) error {
@@ -1312,7 +1401,7 @@ func (m *ListOrdersRequest) Reset()         { *m = ListOrdersRequest{} }
 func (m *ListOrdersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListOrdersRequest) ProtoMessage()    {}
 func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{24}
+	return fileDescriptor_6960a02cc0a63cf6, []int{26}
 }
 
 func (m *ListOrdersRequest) XXX_Unmarshal(b []byte) error {
@@ -1366,7 +1455,7 @@ func (m *ListOrdersResponse) Reset()         { *m = ListOrdersResponse{} }
 func (m *ListOrdersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListOrdersResponse) ProtoMessage()    {}
 func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{25}
+	return fileDescriptor_6960a02cc0a63cf6, []int{27}
 }
 
 func (m *ListOrdersResponse) XXX_Unmarshal(b []byte) error {
@@ -1404,7 +1493,7 @@ func (m *ListPairsRequest) Reset()         { *m = ListPairsRequest{} }
 func (m *ListPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPairsRequest) ProtoMessage()    {}
 func (*ListPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{26}
+	return fileDescriptor_6960a02cc0a63cf6, []int{28}
 }
 
 func (m *ListPairsRequest) XXX_Unmarshal(b []byte) error {
@@ -1437,7 +1526,7 @@ func (m *ListPairsResponse) Reset()         { *m = ListPairsResponse{} }
 func (m *ListPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPairsResponse) ProtoMessage()    {}
 func (*ListPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{27}
+	return fileDescriptor_6960a02cc0a63cf6, []int{29}
 }
 
 func (m *ListPairsResponse) XXX_Unmarshal(b []byte) error {
@@ -1475,7 +1564,7 @@ func (m *ListPeersRequest) Reset()         { *m = ListPeersRequest{} }
 func (m *ListPeersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPeersRequest) ProtoMessage()    {}
 func (*ListPeersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{28}
+	return fileDescriptor_6960a02cc0a63cf6, []int{30}
 }
 
 func (m *ListPeersRequest) XXX_Unmarshal(b []byte) error {
@@ -1508,7 +1597,7 @@ func (m *ListPeersResponse) Reset()         { *m = ListPeersResponse{} }
 func (m *ListPeersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPeersResponse) ProtoMessage()    {}
 func (*ListPeersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{29}
+	return fileDescriptor_6960a02cc0a63cf6, []int{31}
 }
 
 func (m *ListPeersResponse) XXX_Unmarshal(b []byte) error {
@@ -1548,7 +1637,7 @@ func (m *ListTradesRequest) Reset()         { *m = ListTradesRequest{} }
 func (m *ListTradesRequest) String() string { return proto.CompactTextString(m) }
 func (*ListTradesRequest) ProtoMessage()    {}
 func (*ListTradesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{30}
+	return fileDescriptor_6960a02cc0a63cf6, []int{32}
 }
 
 func (m *ListTradesRequest) XXX_Unmarshal(b []byte) error {
@@ -1587,7 +1676,7 @@ func (m *ListTradesResponse) Reset()         { *m = ListTradesResponse{} }
 func (m *ListTradesResponse) String() string { return proto.CompactTextString(m) }
 func (*ListTradesResponse) ProtoMessage()    {}
 func (*ListTradesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{31}
+	return fileDescriptor_6960a02cc0a63cf6, []int{33}
 }
 
 func (m *ListTradesResponse) XXX_Unmarshal(b []byte) error {
@@ -1632,7 +1721,7 @@ func (m *LndInfo) Reset()         { *m = LndInfo{} }
 func (m *LndInfo) String() string { return proto.CompactTextString(m) }
 func (*LndInfo) ProtoMessage()    {}
 func (*LndInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{32}
+	return fileDescriptor_6960a02cc0a63cf6, []int{34}
 }
 
 func (m *LndInfo) XXX_Unmarshal(b []byte) error {
@@ -1720,7 +1809,7 @@ func (m *OpenChannelRequest) Reset()         { *m = OpenChannelRequest{} }
 func (m *OpenChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*OpenChannelRequest) ProtoMessage()    {}
 func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{33}
+	return fileDescriptor_6960a02cc0a63cf6, []int{35}
 }
 
 func (m *OpenChannelRequest) XXX_Unmarshal(b []byte) error {
@@ -1779,7 +1868,7 @@ func (m *OpenChannelResponse) Reset()         { *m = OpenChannelResponse{} }
 func (m *OpenChannelResponse) String() string { return proto.CompactTextString(m) }
 func (*OpenChannelResponse) ProtoMessage()    {}
 func (*OpenChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{34}
+	return fileDescriptor_6960a02cc0a63cf6, []int{36}
 }
 
 func (m *OpenChannelResponse) XXX_Unmarshal(b []byte) error {
@@ -1830,7 +1919,7 @@ func (m *Order) Reset()         { *m = Order{} }
 func (m *Order) String() string { return proto.CompactTextString(m) }
 func (*Order) ProtoMessage()    {}
 func (*Order) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{35}
+	return fileDescriptor_6960a02cc0a63cf6, []int{37}
 }
 
 func (m *Order) XXX_Unmarshal(b []byte) error {
@@ -1972,7 +2061,7 @@ func (m *OrderRemoval) Reset()         { *m = OrderRemoval{} }
 func (m *OrderRemoval) String() string { return proto.CompactTextString(m) }
 func (*OrderRemoval) ProtoMessage()    {}
 func (*OrderRemoval) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{36}
+	return fileDescriptor_6960a02cc0a63cf6, []int{38}
 }
 
 func (m *OrderRemoval) XXX_Unmarshal(b []byte) error {
@@ -2042,7 +2131,7 @@ func (m *Orders) Reset()         { *m = Orders{} }
 func (m *Orders) String() string { return proto.CompactTextString(m) }
 func (*Orders) ProtoMessage()    {}
 func (*Orders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{37}
+	return fileDescriptor_6960a02cc0a63cf6, []int{39}
 }
 
 func (m *Orders) XXX_Unmarshal(b []byte) error {
@@ -2091,7 +2180,7 @@ func (m *OrdersCount) Reset()         { *m = OrdersCount{} }
 func (m *OrdersCount) String() string { return proto.CompactTextString(m) }
 func (*OrdersCount) ProtoMessage()    {}
 func (*OrdersCount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{38}
+	return fileDescriptor_6960a02cc0a63cf6, []int{40}
 }
 
 func (m *OrdersCount) XXX_Unmarshal(b []byte) error {
@@ -2140,7 +2229,7 @@ func (m *OrderUpdate) Reset()         { *m = OrderUpdate{} }
 func (m *OrderUpdate) String() string { return proto.CompactTextString(m) }
 func (*OrderUpdate) ProtoMessage()    {}
 func (*OrderUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{39}
+	return fileDescriptor_6960a02cc0a63cf6, []int{41}
 }
 
 func (m *OrderUpdate) XXX_Unmarshal(b []byte) error {
@@ -2234,7 +2323,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{40}
+	return fileDescriptor_6960a02cc0a63cf6, []int{42}
 }
 
 func (m *Peer) XXX_Unmarshal(b []byte) error {
@@ -2343,7 +2432,7 @@ func (m *PlaceOrderRequest) Reset()         { *m = PlaceOrderRequest{} }
 func (m *PlaceOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*PlaceOrderRequest) ProtoMessage()    {}
 func (*PlaceOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{41}
+	return fileDescriptor_6960a02cc0a63cf6, []int{43}
 }
 
 func (m *PlaceOrderRequest) XXX_Unmarshal(b []byte) error {
@@ -2431,7 +2520,7 @@ func (m *PlaceOrderResponse) Reset()         { *m = PlaceOrderResponse{} }
 func (m *PlaceOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*PlaceOrderResponse) ProtoMessage()    {}
 func (*PlaceOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{42}
+	return fileDescriptor_6960a02cc0a63cf6, []int{44}
 }
 
 func (m *PlaceOrderResponse) XXX_Unmarshal(b []byte) error {
@@ -2496,7 +2585,7 @@ func (m *PlaceOrderEvent) Reset()         { *m = PlaceOrderEvent{} }
 func (m *PlaceOrderEvent) String() string { return proto.CompactTextString(m) }
 func (*PlaceOrderEvent) ProtoMessage()    {}
 func (*PlaceOrderEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{43}
+	return fileDescriptor_6960a02cc0a63cf6, []int{45}
 }
 
 func (m *PlaceOrderEvent) XXX_Unmarshal(b []byte) error {
@@ -2605,7 +2694,7 @@ func (m *RaidenInfo) Reset()         { *m = RaidenInfo{} }
 func (m *RaidenInfo) String() string { return proto.CompactTextString(m) }
 func (*RaidenInfo) ProtoMessage()    {}
 func (*RaidenInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{44}
+	return fileDescriptor_6960a02cc0a63cf6, []int{46}
 }
 
 func (m *RaidenInfo) XXX_Unmarshal(b []byte) error {
@@ -2673,7 +2762,7 @@ func (m *RemoveCurrencyRequest) Reset()         { *m = RemoveCurrencyRequest{} }
 func (m *RemoveCurrencyRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveCurrencyRequest) ProtoMessage()    {}
 func (*RemoveCurrencyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{45}
+	return fileDescriptor_6960a02cc0a63cf6, []int{47}
 }
 
 func (m *RemoveCurrencyRequest) XXX_Unmarshal(b []byte) error {
@@ -2711,7 +2800,7 @@ func (m *RemoveCurrencyResponse) Reset()         { *m = RemoveCurrencyResponse{}
 func (m *RemoveCurrencyResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveCurrencyResponse) ProtoMessage()    {}
 func (*RemoveCurrencyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{46}
+	return fileDescriptor_6960a02cc0a63cf6, []int{48}
 }
 
 func (m *RemoveCurrencyResponse) XXX_Unmarshal(b []byte) error {
@@ -2747,7 +2836,7 @@ func (m *RemoveOrderRequest) Reset()         { *m = RemoveOrderRequest{} }
 func (m *RemoveOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveOrderRequest) ProtoMessage()    {}
 func (*RemoveOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{47}
+	return fileDescriptor_6960a02cc0a63cf6, []int{49}
 }
 
 func (m *RemoveOrderRequest) XXX_Unmarshal(b []byte) error {
@@ -2795,7 +2884,7 @@ func (m *RemoveOrderResponse) Reset()         { *m = RemoveOrderResponse{} }
 func (m *RemoveOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveOrderResponse) ProtoMessage()    {}
 func (*RemoveOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{48}
+	return fileDescriptor_6960a02cc0a63cf6, []int{50}
 }
 
 func (m *RemoveOrderResponse) XXX_Unmarshal(b []byte) error {
@@ -2835,7 +2924,7 @@ func (m *RemovePairRequest) Reset()         { *m = RemovePairRequest{} }
 func (m *RemovePairRequest) String() string { return proto.CompactTextString(m) }
 func (*RemovePairRequest) ProtoMessage()    {}
 func (*RemovePairRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{49}
+	return fileDescriptor_6960a02cc0a63cf6, []int{51}
 }
 
 func (m *RemovePairRequest) XXX_Unmarshal(b []byte) error {
@@ -2873,7 +2962,7 @@ func (m *RemovePairResponse) Reset()         { *m = RemovePairResponse{} }
 func (m *RemovePairResponse) String() string { return proto.CompactTextString(m) }
 func (*RemovePairResponse) ProtoMessage()    {}
 func (*RemovePairResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{50}
+	return fileDescriptor_6960a02cc0a63cf6, []int{52}
 }
 
 func (m *RemovePairResponse) XXX_Unmarshal(b []byte) error {
@@ -2917,7 +3006,7 @@ func (m *RestoreNodeRequest) Reset()         { *m = RestoreNodeRequest{} }
 func (m *RestoreNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreNodeRequest) ProtoMessage()    {}
 func (*RestoreNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{51}
+	return fileDescriptor_6960a02cc0a63cf6, []int{53}
 }
 
 func (m *RestoreNodeRequest) XXX_Unmarshal(b []byte) error {
@@ -2994,7 +3083,7 @@ func (m *RestoreNodeResponse) Reset()         { *m = RestoreNodeResponse{} }
 func (m *RestoreNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreNodeResponse) ProtoMessage()    {}
 func (*RestoreNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{52}
+	return fileDescriptor_6960a02cc0a63cf6, []int{54}
 }
 
 func (m *RestoreNodeResponse) XXX_Unmarshal(b []byte) error {
@@ -3039,7 +3128,7 @@ func (m *ShutdownRequest) Reset()         { *m = ShutdownRequest{} }
 func (m *ShutdownRequest) String() string { return proto.CompactTextString(m) }
 func (*ShutdownRequest) ProtoMessage()    {}
 func (*ShutdownRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{53}
+	return fileDescriptor_6960a02cc0a63cf6, []int{55}
 }
 
 func (m *ShutdownRequest) XXX_Unmarshal(b []byte) error {
@@ -3070,7 +3159,7 @@ func (m *ShutdownResponse) Reset()         { *m = ShutdownResponse{} }
 func (m *ShutdownResponse) String() string { return proto.CompactTextString(m) }
 func (*ShutdownResponse) ProtoMessage()    {}
 func (*ShutdownResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{54}
+	return fileDescriptor_6960a02cc0a63cf6, []int{56}
 }
 
 func (m *ShutdownResponse) XXX_Unmarshal(b []byte) error {
@@ -3103,7 +3192,7 @@ func (m *SubscribeOrdersRequest) Reset()         { *m = SubscribeOrdersRequest{}
 func (m *SubscribeOrdersRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeOrdersRequest) ProtoMessage()    {}
 func (*SubscribeOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{55}
+	return fileDescriptor_6960a02cc0a63cf6, []int{57}
 }
 
 func (m *SubscribeOrdersRequest) XXX_Unmarshal(b []byte) error {
@@ -3144,7 +3233,7 @@ func (m *SubscribeSwapsRequest) Reset()         { *m = SubscribeSwapsRequest{} }
 func (m *SubscribeSwapsRequest) String() string { return proto.CompactTextString(m) }
 func (*SubscribeSwapsRequest) ProtoMessage()    {}
 func (*SubscribeSwapsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{56}
+	return fileDescriptor_6960a02cc0a63cf6, []int{58}
 }
 
 func (m *SubscribeSwapsRequest) XXX_Unmarshal(b []byte) error {
@@ -3192,7 +3281,7 @@ func (m *SwapFailure) Reset()         { *m = SwapFailure{} }
 func (m *SwapFailure) String() string { return proto.CompactTextString(m) }
 func (*SwapFailure) ProtoMessage()    {}
 func (*SwapFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{57}
+	return fileDescriptor_6960a02cc0a63cf6, []int{59}
 }
 
 func (m *SwapFailure) XXX_Unmarshal(b []byte) error {
@@ -3284,7 +3373,7 @@ func (m *SwapSuccess) Reset()         { *m = SwapSuccess{} }
 func (m *SwapSuccess) String() string { return proto.CompactTextString(m) }
 func (*SwapSuccess) ProtoMessage()    {}
 func (*SwapSuccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{58}
+	return fileDescriptor_6960a02cc0a63cf6, []int{60}
 }
 
 func (m *SwapSuccess) XXX_Unmarshal(b []byte) error {
@@ -3410,7 +3499,7 @@ func (m *TradingLimits) Reset()         { *m = TradingLimits{} }
 func (m *TradingLimits) String() string { return proto.CompactTextString(m) }
 func (*TradingLimits) ProtoMessage()    {}
 func (*TradingLimits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{59}
+	return fileDescriptor_6960a02cc0a63cf6, []int{61}
 }
 
 func (m *TradingLimits) XXX_Unmarshal(b []byte) error {
@@ -3465,7 +3554,7 @@ func (m *Trade) Reset()         { *m = Trade{} }
 func (m *Trade) String() string { return proto.CompactTextString(m) }
 func (*Trade) ProtoMessage()    {}
 func (*Trade) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{60}
+	return fileDescriptor_6960a02cc0a63cf6, []int{62}
 }
 
 func (m *Trade) XXX_Unmarshal(b []byte) error {
@@ -3534,7 +3623,7 @@ func (m *TradingLimitsRequest) Reset()         { *m = TradingLimitsRequest{} }
 func (m *TradingLimitsRequest) String() string { return proto.CompactTextString(m) }
 func (*TradingLimitsRequest) ProtoMessage()    {}
 func (*TradingLimitsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{61}
+	return fileDescriptor_6960a02cc0a63cf6, []int{63}
 }
 
 func (m *TradingLimitsRequest) XXX_Unmarshal(b []byte) error {
@@ -3574,7 +3663,7 @@ func (m *TradingLimitsResponse) Reset()         { *m = TradingLimitsResponse{} }
 func (m *TradingLimitsResponse) String() string { return proto.CompactTextString(m) }
 func (*TradingLimitsResponse) ProtoMessage()    {}
 func (*TradingLimitsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{62}
+	return fileDescriptor_6960a02cc0a63cf6, []int{64}
 }
 
 func (m *TradingLimitsResponse) XXX_Unmarshal(b []byte) error {
@@ -3616,7 +3705,7 @@ func (m *UnbanRequest) Reset()         { *m = UnbanRequest{} }
 func (m *UnbanRequest) String() string { return proto.CompactTextString(m) }
 func (*UnbanRequest) ProtoMessage()    {}
 func (*UnbanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{63}
+	return fileDescriptor_6960a02cc0a63cf6, []int{65}
 }
 
 func (m *UnbanRequest) XXX_Unmarshal(b []byte) error {
@@ -3661,7 +3750,7 @@ func (m *UnbanResponse) Reset()         { *m = UnbanResponse{} }
 func (m *UnbanResponse) String() string { return proto.CompactTextString(m) }
 func (*UnbanResponse) ProtoMessage()    {}
 func (*UnbanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{64}
+	return fileDescriptor_6960a02cc0a63cf6, []int{66}
 }
 
 func (m *UnbanResponse) XXX_Unmarshal(b []byte) error {
@@ -3695,7 +3784,7 @@ func (m *UnlockNodeRequest) Reset()         { *m = UnlockNodeRequest{} }
 func (m *UnlockNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlockNodeRequest) ProtoMessage()    {}
 func (*UnlockNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{65}
+	return fileDescriptor_6960a02cc0a63cf6, []int{67}
 }
 
 func (m *UnlockNodeRequest) XXX_Unmarshal(b []byte) error {
@@ -3739,7 +3828,7 @@ func (m *UnlockNodeResponse) Reset()         { *m = UnlockNodeResponse{} }
 func (m *UnlockNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*UnlockNodeResponse) ProtoMessage()    {}
 func (*UnlockNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6960a02cc0a63cf6, []int{66}
+	return fileDescriptor_6960a02cc0a63cf6, []int{68}
 }
 
 func (m *UnlockNodeResponse) XXX_Unmarshal(b []byte) error {
@@ -3794,6 +3883,8 @@ func init() {
 	proto.RegisterType((*BanResponse)(nil), "xudrpc.BanResponse")
 	proto.RegisterType((*Chain)(nil), "xudrpc.Chain")
 	proto.RegisterType((*Channels)(nil), "xudrpc.Channels")
+	proto.RegisterType((*CloseChannelRequest)(nil), "xudrpc.CloseChannelRequest")
+	proto.RegisterType((*CloseChannelResponse)(nil), "xudrpc.CloseChannelResponse")
 	proto.RegisterType((*ConnectRequest)(nil), "xudrpc.ConnectRequest")
 	proto.RegisterType((*ConnectResponse)(nil), "xudrpc.ConnectResponse")
 	proto.RegisterType((*CreateNodeRequest)(nil), "xudrpc.CreateNodeRequest")
@@ -3864,222 +3955,225 @@ func init() {
 func init() { proto.RegisterFile("xudrpc.proto", fileDescriptor_6960a02cc0a63cf6) }
 
 var fileDescriptor_6960a02cc0a63cf6 = []byte{
-	// 3440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x3a, 0x4b, 0x8f, 0x1d, 0x47,
-	0xd5, 0xd3, 0xf7, 0x3d, 0xe7, 0xbe, 0x6b, 0x66, 0xec, 0xeb, 0x6b, 0xc7, 0x71, 0xfa, 0x73, 0xf2,
-	0x39, 0x8e, 0x33, 0x36, 0x13, 0x82, 0x63, 0x87, 0x44, 0xf1, 0x8c, 0x87, 0xd8, 0xc9, 0xf8, 0xa1,
-	0x9e, 0x38, 0x31, 0x08, 0xd2, 0xea, 0xdb, 0x5d, 0xf6, 0x34, 0xee, 0x5b, 0x7d, 0xd3, 0x8f, 0x19,
-	0x0f, 0x2b, 0x94, 0xb0, 0x82, 0x65, 0x58, 0x22, 0xc1, 0x8a, 0x0d, 0x6c, 0x11, 0x12, 0x7f, 0x03,
-	0x09, 0x58, 0x20, 0x21, 0x24, 0x7e, 0x08, 0xaa, 0x57, 0x77, 0x55, 0x77, 0x5f, 0xc7, 0x8e, 0xd8,
-	0x75, 0x9d, 0x3a, 0x75, 0x4e, 0xd5, 0xa9, 0x53, 0xe7, 0xd9, 0xd0, 0x7b, 0x9a, 0x7a, 0xd1, 0xc2,
-	0xdd, 0x5c, 0x44, 0x61, 0x12, 0xa2, 0x16, 0x1f, 0x4d, 0xc7, 0x0e, 0x21, 0x61, 0xe2, 0x24, 0x7e,
-	0x48, 0x62, 0x3e, 0x65, 0x6e, 0xc0, 0xda, 0x0d, 0xcf, 0xdb, 0x49, 0xa3, 0x08, 0x13, 0xf7, 0xd8,
-	0xc2, 0xf1, 0x22, 0x24, 0x31, 0x36, 0x3f, 0x87, 0xc1, 0x0d, 0xcf, 0xbb, 0xef, 0xf8, 0x91, 0x85,
-	0xbf, 0x48, 0x71, 0x9c, 0xa0, 0xf3, 0xd0, 0x9f, 0x39, 0x31, 0xb6, 0x5d, 0x81, 0x3a, 0x31, 0xce,
-	0x19, 0x17, 0x56, 0x2d, 0x1d, 0x88, 0x5e, 0x83, 0xc1, 0x17, 0x69, 0x98, 0x28, 0x68, 0x35, 0x86,
-	0x56, 0x80, 0x9a, 0x63, 0x18, 0x66, 0xf4, 0x05, 0xcb, 0x3f, 0xd7, 0xa0, 0xbd, 0xed, 0x04, 0x0e,
-	0x71, 0x31, 0x65, 0x96, 0x84, 0x89, 0x13, 0xd8, 0x33, 0x0e, 0x60, 0xcc, 0x1a, 0x96, 0x0e, 0x44,
-	0x17, 0x60, 0xe8, 0x1e, 0x38, 0x84, 0xe0, 0x1c, 0xaf, 0xc6, 0xf0, 0x8a, 0x60, 0xf4, 0x0e, 0x9c,
-	0x5c, 0x60, 0xe2, 0xf9, 0xe4, 0xb1, 0x5d, 0x5c, 0x51, 0x67, 0x2b, 0x96, 0x4d, 0xa3, 0xeb, 0x30,
-	0xf1, 0x89, 0xe3, 0x26, 0xfe, 0x21, 0x2e, 0x2d, 0x6d, 0xb0, 0xa5, 0x4b, 0xe7, 0xa9, 0x30, 0x8e,
-	0x9c, 0x20, 0xc0, 0x49, 0xb6, 0xa2, 0xc9, 0x56, 0x14, 0xa0, 0xe8, 0x7d, 0x98, 0xa6, 0xc4, 0x0d,
-	0xc9, 0x23, 0x3f, 0x9a, 0x63, 0xcf, 0x2e, 0xac, 0x69, 0xb1, 0x35, 0xcf, 0xc0, 0x30, 0xbf, 0x07,
-	0xb0, 0xed, 0x10, 0x79, 0x51, 0x17, 0x60, 0x48, 0x42, 0x0f, 0xdb, 0xbe, 0x87, 0x49, 0xe2, 0x3f,
-	0xf2, 0x71, 0x24, 0xae, 0xaa, 0x08, 0x36, 0xfb, 0xd0, 0x65, 0xeb, 0xc4, 0x05, 0x5c, 0x85, 0xe6,
-	0xce, 0x81, 0xe3, 0x13, 0xb4, 0x0e, 0x4d, 0x97, 0x7e, 0x88, 0x75, 0x7c, 0x80, 0x26, 0xd0, 0x26,
-	0x38, 0x39, 0x0a, 0xa3, 0x27, 0xe2, 0x4e, 0xe5, 0xd0, 0x5c, 0x40, 0x67, 0x87, 0x1f, 0x3d, 0x46,
-	0x27, 0xa0, 0xc5, 0xa5, 0xc1, 0x16, 0xf7, 0x2d, 0x31, 0x42, 0x53, 0xe8, 0x48, 0x39, 0xb1, 0xe5,
-	0x7d, 0x2b, 0x1b, 0x53, 0xca, 0x42, 0xfc, 0xec, 0x36, 0xfa, 0x96, 0x1c, 0x52, 0x6a, 0x6e, 0x10,
-	0xc6, 0xd8, 0x63, 0xb2, 0xee, 0x5b, 0x62, 0x64, 0x5e, 0x82, 0xc1, 0x4e, 0x48, 0x08, 0x76, 0x13,
-	0x79, 0xea, 0x29, 0x74, 0xd8, 0xf1, 0xd2, 0xc8, 0x17, 0xdb, 0xce, 0xc6, 0x54, 0xd9, 0x32, 0x6c,
-	0x71, 0xd6, 0xcb, 0x30, 0xde, 0x89, 0xb0, 0x93, 0xe0, 0xbb, 0xa1, 0x87, 0x15, 0x1a, 0x0b, 0x27,
-	0x8e, 0x8f, 0xc2, 0xc8, 0x93, 0x34, 0xe4, 0xd8, 0xfc, 0xda, 0x00, 0xa4, 0xae, 0xe0, 0x74, 0xd0,
-	0xff, 0x41, 0x3f, 0xc6, 0xd8, 0xb3, 0xe7, 0x04, 0xcf, 0x43, 0xe2, 0xbb, 0x13, 0xe3, 0x5c, 0xfd,
-	0xc2, 0xaa, 0xd5, 0xa3, 0xc0, 0x3b, 0x02, 0x86, 0x5e, 0x87, 0x91, 0x4f, 0xfc, 0xc4, 0x77, 0x02,
-	0xff, 0x67, 0xd8, 0xb3, 0x03, 0xe2, 0xc5, 0x93, 0x1a, 0xc3, 0x1b, 0x2a, 0xf0, 0x3d, 0xe2, 0xc5,
-	0xe8, 0x4d, 0x40, 0x2a, 0x6a, 0xe4, 0xd0, 0xeb, 0x62, 0x52, 0xe9, 0x58, 0x63, 0x65, 0xc6, 0x62,
-	0x13, 0xe6, 0xdf, 0x0c, 0xe8, 0xc8, 0xb7, 0x4b, 0xb7, 0x5f, 0x78, 0x9c, 0xd9, 0x18, 0xbd, 0x07,
-	0xdd, 0xf8, 0xc8, 0x59, 0xd8, 0x6e, 0xe0, 0x63, 0x92, 0xb0, 0x1b, 0x18, 0x6c, 0x9d, 0xde, 0x14,
-	0x56, 0x42, 0x92, 0xd8, 0xdc, 0x3f, 0x72, 0x16, 0x3b, 0x0c, 0xc5, 0x52, 0xf1, 0xf9, 0x7b, 0x7c,
-	0x82, 0x89, 0xed, 0x78, 0x5e, 0x84, 0xe3, 0x98, 0xed, 0x68, 0xd5, 0xd2, 0x81, 0x54, 0xdf, 0x3d,
-	0xec, 0xfa, 0x73, 0x27, 0xb0, 0x17, 0x81, 0xe3, 0xe2, 0x58, 0xdc, 0x5a, 0x01, 0x6a, 0xbe, 0x02,
-	0x90, 0x33, 0x42, 0x6d, 0xa8, 0xef, 0xdd, 0xbd, 0x39, 0x5a, 0x41, 0x00, 0x2d, 0xeb, 0xc6, 0xed,
-	0x9b, 0xbb, 0x77, 0x47, 0x86, 0xf9, 0x01, 0xac, 0xdf, 0xf4, 0x63, 0x37, 0x3c, 0xc4, 0x11, 0x95,
-	0x77, 0xfc, 0xe2, 0xca, 0xfd, 0x36, 0x6c, 0x14, 0x28, 0x88, 0x2b, 0x3b, 0x03, 0xab, 0x24, 0x9d,
-	0xdb, 0x14, 0x3f, 0x16, 0x4a, 0x9a, 0x03, 0xcc, 0x5f, 0x1a, 0x80, 0x76, 0x9f, 0x62, 0x37, 0x4d,
-	0x30, 0xdd, 0xa3, 0xa2, 0x1a, 0x61, 0xe4, 0xe1, 0xc8, 0xf6, 0x33, 0xd5, 0x90, 0x63, 0xa6, 0xbe,
-	0x8e, 0xcf, 0xa6, 0xc4, 0xc3, 0x10, 0x43, 0x64, 0x42, 0x6f, 0x81, 0x71, 0x64, 0x2f, 0xd2, 0x99,
-	0xfd, 0x04, 0x1f, 0x0b, 0xa9, 0x69, 0x30, 0x4a, 0xf9, 0x8b, 0xd4, 0x21, 0x89, 0x9f, 0x1c, 0x0b,
-	0x83, 0x92, 0x8d, 0xa9, 0x96, 0x7e, 0x88, 0x13, 0x61, 0x14, 0x95, 0xad, 0x2c, 0xbb, 0x66, 0xf3,
-	0xf7, 0x06, 0x20, 0x75, 0x85, 0x38, 0xf2, 0x36, 0x74, 0x84, 0xad, 0x88, 0x99, 0x82, 0x76, 0xb7,
-	0x2e, 0xc8, 0xab, 0x2f, 0x63, 0x6f, 0x8a, 0x71, 0xbc, 0x4b, 0x92, 0xe8, 0xd8, 0x6a, 0xb1, 0x73,
-	0xc6, 0xd3, 0x3d, 0xe8, 0x6b, 0x13, 0x68, 0x04, 0x75, 0x7a, 0x26, 0xbe, 0x05, 0xfa, 0x89, 0x5e,
-	0x85, 0xe6, 0xa1, 0x13, 0xa4, 0xfc, 0x81, 0x77, 0xb7, 0x86, 0x92, 0x87, 0x64, 0xc0, 0x67, 0xaf,
-	0xd7, 0xde, 0x31, 0xcc, 0x11, 0x0c, 0x3e, 0xc4, 0xc9, 0x6d, 0xf2, 0x28, 0x14, 0xc7, 0x32, 0xff,
-	0x5e, 0x87, 0x61, 0x06, 0x12, 0xfb, 0x9e, 0x40, 0xfb, 0x10, 0x47, 0xb1, 0x1f, 0x4a, 0x53, 0x24,
-	0x87, 0x54, 0xb2, 0xec, 0xc2, 0xa5, 0x64, 0xb9, 0xe0, 0x35, 0x18, 0x42, 0xd0, 0x48, 0x23, 0x9f,
-	0xea, 0x2a, 0x7d, 0x6a, 0xec, 0x5b, 0x5e, 0x3e, 0xbd, 0x01, 0xa9, 0x9d, 0x39, 0x20, 0x9b, 0x75,
-	0xfc, 0x28, 0x66, 0xb6, 0x5a, 0xce, 0x52, 0x00, 0x7a, 0x03, 0x84, 0x2c, 0x98, 0x49, 0xee, 0x6e,
-	0xad, 0xc9, 0xf3, 0xdd, 0x63, 0xd0, 0x9d, 0x30, 0x25, 0x89, 0x14, 0x17, 0xda, 0x82, 0x7a, 0x40,
-	0xbc, 0x49, 0x9b, 0x49, 0xfb, 0x9c, 0x22, 0x6d, 0xf5, 0x80, 0x9b, 0x7b, 0xc4, 0xe3, 0x52, 0xa6,
-	0xc8, 0xe8, 0x22, 0xb4, 0xc4, 0x83, 0xef, 0x30, 0x06, 0x48, 0x2e, 0xe3, 0xaf, 0x9d, 0xad, 0x14,
-	0x18, 0xd4, 0x46, 0x3b, 0x81, 0xef, 0xc4, 0x93, 0x55, 0x6e, 0xa3, 0xd9, 0x40, 0xb5, 0xd1, 0xa0,
-	0xd9, 0x68, 0x74, 0x05, 0xd6, 0xa4, 0x8b, 0x63, 0x0f, 0xfb, 0xc0, 0x89, 0x0f, 0x70, 0x3c, 0xe9,
-	0x32, 0xd9, 0x54, 0x4d, 0x4d, 0x3f, 0x84, 0x8e, 0xdc, 0xde, 0x0b, 0xdc, 0xf5, 0x1e, 0xf1, 0xd8,
-	0x3e, 0x95, 0xbb, 0x7e, 0x9f, 0xe9, 0x24, 0x7d, 0x84, 0xca, 0x7d, 0xbf, 0xc0, 0x4b, 0xb6, 0x60,
-	0x4d, 0x5b, 0x9f, 0x99, 0xde, 0x61, 0x84, 0x17, 0x29, 0x0f, 0x67, 0xf6, 0xdd, 0x30, 0xe2, 0x2e,
-	0x67, 0x6c, 0x41, 0x0e, 0xa6, 0x0e, 0x64, 0x46, 0x3d, 0x13, 0x7f, 0x9a, 0x1d, 0x4b, 0x8c, 0xcc,
-	0x93, 0xb0, 0xb1, 0xe7, 0xc7, 0x89, 0x30, 0x7c, 0x7e, 0x66, 0x60, 0xcc, 0x8f, 0xe0, 0x44, 0x71,
-	0x42, 0xf0, 0xbb, 0x02, 0xe0, 0x66, 0x50, 0xf1, 0x8c, 0x46, 0x45, 0x0b, 0x6a, 0x29, 0x38, 0xe6,
-	0xef, 0x0c, 0x18, 0x53, 0x62, 0x5c, 0x3f, 0xe4, 0xc1, 0x15, 0x73, 0x61, 0xe8, 0xe6, 0xe2, 0x6d,
-	0x68, 0x86, 0x47, 0x04, 0x47, 0xc2, 0x3c, 0xbf, 0x9c, 0xc9, 0xb4, 0x48, 0x63, 0xf3, 0x1e, 0x45,
-	0xb3, 0x38, 0x36, 0x55, 0x85, 0xc0, 0x9f, 0xfb, 0x89, 0x70, 0x9e, 0x7c, 0x60, 0x9e, 0x87, 0x26,
-	0xc3, 0x42, 0x1d, 0x68, 0x6c, 0xdf, 0xfb, 0xe4, 0xd6, 0x68, 0x85, 0x5a, 0xda, 0x7b, 0x9f, 0xdd,
-	0x1d, 0x19, 0x14, 0x74, 0x7f, 0x77, 0xd7, 0x1a, 0xd5, 0xcc, 0xdf, 0x1a, 0x80, 0x54, 0xf2, 0xe2,
-	0xac, 0xef, 0x67, 0xaa, 0xce, 0xcf, 0xf9, 0x5a, 0xd5, 0x56, 0x84, 0x0e, 0xf3, 0xa1, 0x6e, 0x2c,
-	0x6e, 0x43, 0x57, 0x01, 0x57, 0xa8, 0xcf, 0x79, 0x5d, 0x7d, 0x06, 0xfa, 0x53, 0x52, 0xb5, 0x07,
-	0xc1, 0x88, 0x32, 0xa5, 0xa1, 0x62, 0x76, 0x49, 0xaf, 0x73, 0xb9, 0x0a, 0x98, 0xd8, 0xf3, 0x3a,
-	0x34, 0xf9, 0xc3, 0xe5, 0x2e, 0x98, 0x0f, 0xb2, 0xe5, 0x38, 0x97, 0x9e, 0x79, 0x55, 0x2c, 0xc7,
-	0xea, 0x91, 0x4d, 0x68, 0x72, 0xab, 0xc0, 0x4f, 0xdc, 0x93, 0x3b, 0xa2, 0x58, 0x16, 0x9f, 0x92,
-	0x7c, 0x3f, 0x89, 0x1c, 0xc5, 0x25, 0x65, 0xe2, 0xa7, 0xc7, 0x6b, 0x4a, 0xf1, 0xbf, 0xcb, 0xe5,
-	0x2a, 0x51, 0x05, 0x93, 0x57, 0xa1, 0x95, 0x30, 0x88, 0xe0, 0xd2, 0x97, 0x5c, 0x18, 0x9e, 0x25,
-	0x26, 0xcd, 0x7f, 0x1a, 0xd0, 0x16, 0x0f, 0x89, 0x6a, 0x70, 0x9c, 0x38, 0x49, 0x1a, 0x0b, 0xf1,
-	0x89, 0x11, 0xba, 0x04, 0x1d, 0x11, 0x6f, 0xc6, 0x42, 0x88, 0xb9, 0x32, 0x0a, 0xb8, 0x95, 0x61,
-	0x50, 0xc6, 0x2c, 0x8a, 0xe3, 0xd6, 0x50, 0x61, 0xcc, 0x22, 0x3e, 0x4b, 0x4c, 0xa2, 0x73, 0xd0,
-	0x9d, 0x05, 0xa1, 0xfb, 0xe4, 0x00, 0xfb, 0x8f, 0x0f, 0x12, 0x61, 0x20, 0x55, 0x50, 0x66, 0x54,
-	0x9b, 0x8a, 0x51, 0x55, 0xcc, 0x74, 0x4b, 0x37, 0xd3, 0x99, 0x95, 0x6a, 0x2b, 0x56, 0xca, 0xfc,
-	0xb5, 0x01, 0xe8, 0xde, 0x02, 0x13, 0xb1, 0xcf, 0x17, 0xb6, 0x08, 0x9a, 0x0b, 0xac, 0x15, 0x22,
-	0x1d, 0x1a, 0x80, 0xce, 0xa9, 0x29, 0x66, 0xcf, 0xa1, 0x6e, 0x89, 0x11, 0x3d, 0xda, 0x22, 0x8d,
-	0x0f, 0x6c, 0x31, 0xd9, 0x60, 0x93, 0x2a, 0x88, 0xa6, 0x42, 0xda, 0xae, 0x44, 0xa8, 0xf8, 0xa7,
-	0x1a, 0x34, 0x99, 0x5a, 0x32, 0x0d, 0x8b, 0x7c, 0x91, 0x8d, 0x18, 0x16, 0x1f, 0x68, 0x0e, 0xbc,
-	0xa6, 0x3b, 0x70, 0xf5, 0xad, 0xd7, 0xf5, 0xb7, 0x3e, 0x80, 0x9a, 0xcf, 0xa3, 0xda, 0x55, 0xab,
-	0xe6, 0x7b, 0xe8, 0x7c, 0x21, 0x54, 0xa0, 0xde, 0x67, 0xf5, 0xd6, 0x4a, 0x21, 0x58, 0x38, 0x03,
-	0x9d, 0x20, 0x74, 0x9d, 0x80, 0x12, 0x6c, 0x09, 0x8c, 0x0c, 0x82, 0xce, 0x02, 0xb8, 0x2c, 0x44,
-	0xf5, 0x6c, 0x27, 0x61, 0x22, 0x6f, 0x58, 0x0a, 0x04, 0xbd, 0x0a, 0x8d, 0xd8, 0xf7, 0x30, 0xf3,
-	0x2e, 0x83, 0xad, 0xb1, 0xf6, 0xe6, 0xf6, 0x7d, 0x0f, 0x5b, 0x6c, 0x9a, 0xfa, 0x56, 0x3f, 0xb6,
-	0xc3, 0x23, 0x62, 0xb3, 0xd7, 0xcc, 0x3c, 0x4c, 0xc7, 0xd2, 0x60, 0x54, 0x0d, 0x0e, 0xc2, 0xc0,
-	0x63, 0x5e, 0xa6, 0x61, 0xb1, 0xef, 0xed, 0x3e, 0x74, 0x39, 0x02, 0xf3, 0xa6, 0xd4, 0xfa, 0xf5,
-	0x18, 0x69, 0x0b, 0xcf, 0xc3, 0x43, 0x27, 0xd0, 0x04, 0x65, 0x2c, 0x17, 0x54, 0x21, 0x86, 0x52,
-	0x23, 0xaf, 0x7a, 0x21, 0xf2, 0x9a, 0x2a, 0xe2, 0xe0, 0xa2, 0xcc, 0x85, 0x51, 0x3c, 0x45, 0xb3,
-	0x7c, 0x0a, 0xf3, 0x00, 0x5a, 0xdc, 0xe0, 0xa0, 0x37, 0x01, 0x66, 0xe9, 0xb1, 0xad, 0x19, 0xbd,
-	0xbe, 0x26, 0x20, 0x4b, 0x41, 0x40, 0x97, 0xa1, 0x1b, 0xe3, 0x20, 0x90, 0xf8, 0xb5, 0x2a, 0x7c,
-	0x15, 0xc3, 0x7c, 0x4b, 0x1a, 0x44, 0x16, 0x25, 0x50, 0xf1, 0x51, 0x19, 0x89, 0xf0, 0x93, 0x7d,
-	0x53, 0x23, 0x19, 0x1e, 0x11, 0x91, 0x1c, 0xd1, 0x4f, 0xf3, 0x4b, 0x43, 0xac, 0x7a, 0xb0, 0xf0,
-	0x9c, 0x84, 0x5a, 0x8f, 0x26, 0x3f, 0x8b, 0xc1, 0xde, 0xbb, 0xce, 0xef, 0xd6, 0x8a, 0xc5, 0x67,
-	0xd1, 0xf7, 0xa1, 0xcf, 0x25, 0x14, 0x71, 0xc1, 0x0b, 0xf3, 0xb0, 0xae, 0x6f, 0x8f, 0xcf, 0xdd,
-	0x5a, 0xb1, 0x74, 0xe4, 0xed, 0x01, 0xf4, 0x38, 0x20, 0x65, 0x4c, 0xcd, 0xaf, 0xea, 0xd0, 0xa0,
-	0x36, 0x90, 0x5e, 0x91, 0x8c, 0xfe, 0x85, 0xdf, 0x92, 0x71, 0xff, 0xf3, 0x04, 0x63, 0x1f, 0x40,
-	0x2f, 0x20, 0x9e, 0x1c, 0x4a, 0x33, 0x74, 0x46, 0xb5, 0xb2, 0x34, 0x76, 0xb8, 0x9f, 0xce, 0x3e,
-	0xc6, 0xc7, 0xc2, 0x9b, 0x68, 0x2b, 0x28, 0x7f, 0x9f, 0xcc, 0xc2, 0x94, 0xf0, 0xbb, 0xee, 0x58,
-	0x72, 0x98, 0x5b, 0xfe, 0xa6, 0x62, 0xf9, 0xe9, 0x83, 0x7f, 0x9a, 0x7a, 0xb6, 0x6e, 0x99, 0x54,
-	0x10, 0xba, 0x04, 0xe3, 0x18, 0xbb, 0x21, 0xf1, 0x62, 0xdb, 0xe5, 0xf9, 0x21, 0xf6, 0xd8, 0xb3,
-	0xe9, 0x5b, 0xe5, 0x09, 0x9a, 0xdd, 0xf0, 0xd8, 0x2b, 0x4b, 0x82, 0x3a, 0xbc, 0xb4, 0xa1, 0x43,
-	0xab, 0x23, 0xb3, 0xe9, 0x7b, 0x30, 0x2c, 0x1c, 0xaf, 0xc2, 0x2b, 0xae, 0xab, 0x5e, 0x71, 0x55,
-	0xf5, 0x82, 0x3f, 0xaf, 0xc1, 0xf8, 0x3e, 0xcd, 0x9e, 0xc4, 0xe5, 0x65, 0xae, 0xe7, 0x7f, 0x66,
-	0x90, 0xd4, 0x77, 0xd6, 0x28, 0xbc, 0x33, 0x69, 0x38, 0x9a, 0xcf, 0x36, 0x1c, 0x17, 0x61, 0x14,
-	0x61, 0x96, 0xe3, 0xd9, 0x19, 0x29, 0x2e, 0xf6, 0x12, 0x9c, 0xc6, 0xa3, 0xfe, 0x7c, 0x8e, 0x3d,
-	0xdf, 0x49, 0x28, 0xd4, 0x76, 0x69, 0x86, 0x10, 0x30, 0xe9, 0x77, 0xac, 0xaa, 0x29, 0x2a, 0x02,
-	0xa4, 0x8a, 0x40, 0xb8, 0xd4, 0x6b, 0x34, 0xb9, 0x4e, 0x70, 0x44, 0x9c, 0xc0, 0x9e, 0x3b, 0x89,
-	0x7b, 0x80, 0x97, 0xbc, 0xdf, 0x12, 0x1a, 0x7a, 0x17, 0x06, 0x2c, 0xe0, 0x8d, 0x53, 0xd7, 0xc5,
-	0x71, 0x8c, 0xe5, 0x43, 0xce, 0x02, 0x7b, 0x9a, 0x01, 0xee, 0xf3, 0x49, 0xab, 0x80, 0x8a, 0xae,
-	0xd2, 0xf0, 0x73, 0xee, 0xf8, 0x84, 0xc6, 0xcd, 0xfc, 0x59, 0xd6, 0x2b, 0x9e, 0xa5, 0x55, 0xc4,
-	0x42, 0xd7, 0xa0, 0xcf, 0x48, 0x3d, 0x72, 0xfc, 0x20, 0x8d, 0x58, 0x92, 0x5c, 0x62, 0xfa, 0x03,
-	0x3e, 0x67, 0xe9, 0x98, 0xe6, 0x2f, 0x6a, 0x30, 0xcc, 0x45, 0xb0, 0x7b, 0x48, 0xd3, 0xe7, 0xab,
-	0x30, 0xd0, 0x0f, 0xb6, 0xcc, 0x3a, 0x14, 0xd0, 0xd0, 0x35, 0xe8, 0xa9, 0x47, 0x12, 0x56, 0xa2,
-	0xea, 0xec, 0xd4, 0x0d, 0xa9, 0xa8, 0xe8, 0xda, 0xf3, 0x9d, 0xfd, 0xd6, 0x4a, 0xd5, 0xe9, 0x7b,
-	0xea, 0x99, 0x98, 0xaa, 0x55, 0x1f, 0x3e, 0xe3, 0x2a, 0x50, 0xb7, 0xdb, 0xd0, 0xc4, 0xf4, 0xc8,
-	0xe6, 0x6f, 0x0c, 0x80, 0x3c, 0x25, 0x5a, 0x1a, 0x21, 0x29, 0x06, 0xab, 0xa6, 0x1b, 0x2c, 0x35,
-	0x76, 0xaa, 0x7f, 0x63, 0xec, 0xa4, 0x84, 0x37, 0x8d, 0x52, 0x78, 0xc3, 0x0b, 0x65, 0x4d, 0xa5,
-	0x50, 0x66, 0xbe, 0x05, 0x1b, 0xcc, 0xba, 0xe2, 0xbc, 0xaa, 0xfa, 0xcd, 0x99, 0xfb, 0x04, 0x4e,
-	0x14, 0x17, 0x89, 0xf8, 0x63, 0x0f, 0x10, 0x9f, 0xd1, 0x9e, 0xfe, 0xb3, 0x0a, 0x12, 0xcf, 0x30,
-	0x00, 0xe6, 0xdb, 0xb0, 0xa6, 0x51, 0x13, 0xaf, 0xe8, 0x2c, 0x8c, 0x24, 0x8a, 0x1d, 0x12, 0x9b,
-	0xf9, 0x76, 0x23, 0xf7, 0xed, 0xe6, 0x9b, 0x30, 0xe6, 0xcb, 0xd4, 0x92, 0xf0, 0xd2, 0x4c, 0xc6,
-	0x5c, 0x97, 0x7b, 0xd6, 0x2a, 0xbc, 0xff, 0xaa, 0x51, 0x70, 0x9c, 0x84, 0x91, 0x56, 0x76, 0x7b,
-	0xae, 0x1a, 0x9a, 0x5a, 0x9b, 0xab, 0xe9, 0xb5, 0x39, 0xf4, 0x31, 0x74, 0xa9, 0xa7, 0x98, 0x39,
-	0xee, 0x93, 0x74, 0x21, 0x5d, 0xcb, 0xc5, 0x2c, 0x79, 0x2e, 0x71, 0xa4, 0x8e, 0x66, 0x9b, 0x23,
-	0x73, 0x47, 0x03, 0x41, 0x06, 0x40, 0xff, 0x0f, 0x43, 0x61, 0xd0, 0x3d, 0x27, 0x71, 0x66, 0x4e,
-	0xcc, 0x75, 0xb4, 0x27, 0xed, 0xfc, 0x4d, 0x01, 0x45, 0x57, 0x60, 0xbd, 0x80, 0x68, 0x2f, 0x9c,
-	0xe4, 0x40, 0xe8, 0x02, 0xd2, 0xb1, 0xef, 0x3b, 0xc9, 0x01, 0x7a, 0x85, 0x95, 0xe5, 0x73, 0xba,
-	0x2d, 0x46, 0x97, 0xba, 0x24, 0x89, 0x26, 0xdc, 0x84, 0xba, 0xb9, 0x6f, 0x72, 0x13, 0x3d, 0xd5,
-	0x4d, 0xb8, 0xf4, 0x76, 0x95, 0xe3, 0xe6, 0x55, 0xca, 0x88, 0x83, 0x45, 0xf5, 0x51, 0x48, 0x58,
-	0x02, 0x59, 0xe9, 0x91, 0x1e, 0x5c, 0x22, 0x89, 0x32, 0x04, 0xcf, 0x99, 0x07, 0x12, 0x2c, 0x8a,
-	0x8e, 0x63, 0x18, 0xee, 0x1f, 0xa4, 0x89, 0x17, 0x1e, 0xc9, 0x9a, 0x33, 0xcd, 0xb2, 0x72, 0x90,
-	0xb8, 0xed, 0xef, 0xc2, 0x89, 0xfd, 0x74, 0x16, 0xbb, 0x91, 0x3f, 0xc3, 0x7a, 0x06, 0x3c, 0x85,
-	0x0e, 0x7e, 0xea, 0xc7, 0x89, 0x4f, 0x1e, 0xb3, 0x63, 0x75, 0xac, 0x6c, 0x6c, 0xbe, 0x07, 0x1b,
-	0xd9, 0x2a, 0x6a, 0x0c, 0x62, 0xa5, 0xff, 0xe0, 0x13, 0x37, 0x48, 0x3d, 0x6c, 0x27, 0xce, 0x13,
-	0x11, 0x04, 0x75, 0x2c, 0x1d, 0x68, 0xfe, 0xc1, 0x80, 0xae, 0x62, 0x43, 0xbe, 0x65, 0xdd, 0x4e,
-	0x7d, 0x40, 0xf5, 0x82, 0x07, 0x2d, 0xd6, 0xf4, 0x1a, 0x15, 0x35, 0xbd, 0xd7, 0x60, 0x20, 0x8c,
-	0x96, 0x1d, 0x61, 0x27, 0x0e, 0xa5, 0x81, 0x28, 0x40, 0xcd, 0x7f, 0xd4, 0xf9, 0x6e, 0x85, 0x9d,
-	0x45, 0xa7, 0x4a, 0xbb, 0x6d, 0xb3, 0xf1, 0x6d, 0x3d, 0xd4, 0xad, 0x15, 0x42, 0xdd, 0x67, 0x3a,
-	0xf5, 0x65, 0xc5, 0x45, 0x6a, 0x36, 0x23, 0x56, 0xeb, 0x11, 0x9b, 0x13, 0x23, 0x9a, 0x86, 0xf1,
-	0x84, 0xc8, 0x8e, 0xb0, 0x8b, 0xfd, 0x43, 0xec, 0xb1, 0x40, 0xa7, 0x61, 0x15, 0xc1, 0x34, 0xc2,
-	0x12, 0xa0, 0x18, 0x93, 0x84, 0xc5, 0x3b, 0x0d, 0x4b, 0x05, 0x95, 0x84, 0x05, 0x15, 0xc2, 0xba,
-	0x04, 0x8d, 0x28, 0x0c, 0xf0, 0xa4, 0xcb, 0x82, 0x8b, 0x49, 0x85, 0xff, 0xd9, 0xb4, 0xc2, 0x00,
-	0x5b, 0x0c, 0x8b, 0xc6, 0x6c, 0xd2, 0x66, 0xe6, 0xfb, 0xeb, 0x31, 0xb2, 0xe5, 0x09, 0xaa, 0x34,
-	0x19, 0x90, 0xed, 0xb1, 0xcf, 0xeb, 0xd6, 0x1a, 0x90, 0xe6, 0x4d, 0x91, 0xbd, 0x88, 0xb0, 0x3f,
-	0x77, 0x1e, 0xe3, 0xc9, 0x80, 0xa1, 0x28, 0x90, 0x3c, 0xcc, 0x1a, 0x2a, 0x61, 0x96, 0x79, 0x06,
-	0x1a, 0x74, 0x5f, 0x68, 0x15, 0x9a, 0x9f, 0xdc, 0xf8, 0x78, 0xd7, 0x1a, 0xad, 0xd0, 0xcf, 0x3b,
-	0xec, 0xd3, 0x30, 0x77, 0xa0, 0x4f, 0x73, 0x7a, 0x9f, 0x3c, 0xde, 0xf3, 0xe7, 0x7e, 0x42, 0xef,
-	0xb6, 0x7d, 0xc7, 0x79, 0xba, 0x8f, 0x83, 0x40, 0x26, 0x3f, 0x73, 0xe7, 0xa9, 0x4d, 0x73, 0x04,
-	0x74, 0x12, 0x5a, 0x77, 0x9c, 0xa7, 0xdb, 0xa9, 0xb4, 0xd6, 0x6d, 0x3a, 0x33, 0x4b, 0x8f, 0xcd,
-	0xbf, 0x18, 0xd0, 0x64, 0x95, 0x01, 0x9a, 0x70, 0xcc, 0xa9, 0x82, 0xdb, 0xcb, 0x13, 0x00, 0x4b,
-	0xc5, 0x40, 0x5b, 0xd0, 0x4d, 0x94, 0x05, 0xb5, 0xaa, 0x05, 0x03, 0x05, 0x83, 0x6a, 0x4b, 0xae,
-	0x11, 0x75, 0x4d, 0x23, 0x8a, 0x5a, 0x54, 0xaf, 0x0e, 0x28, 0x9b, 0xba, 0x0f, 0xd8, 0x82, 0x75,
-	0x4d, 0x02, 0xcf, 0xe3, 0x05, 0xff, 0x68, 0xc0, 0x46, 0x61, 0x91, 0x30, 0x61, 0x37, 0xa0, 0xc5,
-	0x0a, 0x2b, 0x32, 0xb8, 0x7b, 0x5d, 0xad, 0x9c, 0x94, 0xd0, 0x37, 0xf9, 0x50, 0x14, 0xa5, 0xf8,
-	0xc2, 0xe9, 0x7d, 0xe8, 0x2a, 0xe0, 0x0a, 0xbb, 0xfa, 0x86, 0x5e, 0x94, 0xda, 0xa8, 0x66, 0xa1,
-	0x98, 0xdb, 0x4f, 0xa1, 0xf7, 0x80, 0xcc, 0xbe, 0x45, 0xeb, 0x0d, 0x9d, 0x81, 0xd5, 0x08, 0x8b,
-	0xdc, 0x42, 0x98, 0xd9, 0x1c, 0x60, 0x0e, 0xa1, 0x2f, 0xe8, 0xe6, 0xed, 0xaa, 0x07, 0x24, 0x08,
-	0xdd, 0x27, 0xcf, 0xdb, 0xae, 0xfa, 0xca, 0x00, 0xa4, 0xae, 0xc8, 0x1d, 0x41, 0xca, 0xa0, 0x05,
-	0x47, 0x20, 0x81, 0xd2, 0x11, 0x64, 0x48, 0xba, 0x23, 0x90, 0x60, 0xee, 0x08, 0xd0, 0xcb, 0xd0,
-	0x55, 0x69, 0xf1, 0x3a, 0x3b, 0xe4, 0x94, 0x2e, 0x9e, 0x85, 0xd5, 0x2c, 0x47, 0x40, 0x6d, 0xa8,
-	0x6f, 0x3f, 0xf8, 0xe1, 0x68, 0x05, 0x75, 0xa0, 0xb1, 0xbf, 0xbb, 0xb7, 0x37, 0x32, 0xb6, 0xfe,
-	0x6d, 0x40, 0xfb, 0x61, 0xea, 0xdd, 0x26, 0x7e, 0x82, 0x76, 0x01, 0xf2, 0xfe, 0x1a, 0x3a, 0x95,
-	0xc5, 0x63, 0xc5, 0x2e, 0xdd, 0x74, 0x5a, 0x35, 0x25, 0xe4, 0xb4, 0x82, 0x6e, 0x41, 0x57, 0xf1,
-	0x80, 0x68, 0xba, 0x3c, 0x0a, 0x98, 0x9e, 0xae, 0x9c, 0xcb, 0x28, 0xed, 0x02, 0xe4, 0x12, 0xcc,
-	0x37, 0x54, 0xba, 0x87, 0x7c, 0x43, 0x65, 0x81, 0x9b, 0x2b, 0x5b, 0xbf, 0x5a, 0x83, 0xfa, 0xc3,
-	0xd4, 0x43, 0x0f, 0xa1, 0xab, 0x34, 0xda, 0x51, 0xa9, 0x72, 0x9c, 0x6f, 0xa7, 0xaa, 0x1f, 0x3f,
-	0xfd, 0xf2, 0xaf, 0xff, 0xf9, 0xba, 0xb6, 0x6e, 0x0e, 0x2f, 0x1f, 0x7e, 0xe7, 0xb2, 0xe3, 0x79,
-	0xf2, 0xc5, 0x5c, 0x37, 0x2e, 0x22, 0x0b, 0xda, 0xa2, 0x97, 0x8e, 0x4e, 0x28, 0x34, 0x94, 0x48,
-	0x6d, 0x7a, 0xb2, 0x04, 0x17, 0x74, 0x4f, 0x30, 0xba, 0x23, 0xb3, 0x2b, 0xe8, 0xd2, 0xc7, 0x4b,
-	0x69, 0x6e, 0x43, 0x7d, 0xdb, 0x21, 0x08, 0xe5, 0x2d, 0x1c, 0xa9, 0xe4, 0xd3, 0x35, 0x0d, 0x26,
-	0xe8, 0x20, 0x46, 0xa7, 0x67, 0xb6, 0x29, 0x9d, 0x99, 0x43, 0xc4, 0xbe, 0x44, 0xdb, 0x35, 0xdf,
-	0x97, 0xde, 0xb5, 0xcd, 0xf7, 0x55, 0xec, 0xcf, 0x6a, 0xfb, 0x12, 0xcf, 0x82, 0xd2, 0xfc, 0x29,
-	0xf4, 0xb5, 0xae, 0x1e, 0xca, 0x2a, 0x08, 0x55, 0xed, 0xc2, 0xe9, 0x4b, 0x4b, 0x66, 0x05, 0x97,
-	0x33, 0x8c, 0xcb, 0x09, 0x73, 0x4c, 0xb9, 0x78, 0x02, 0x85, 0xf5, 0x01, 0x29, 0xaf, 0x87, 0x00,
-	0x79, 0x77, 0x2c, 0x57, 0x80, 0x52, 0x47, 0x2e, 0x57, 0x80, 0x72, 0x33, 0xcd, 0x5c, 0x63, 0x2c,
-	0xfa, 0xa8, 0xcb, 0x05, 0xc3, 0x69, 0xed, 0x41, 0x5b, 0x74, 0x82, 0x72, 0xc9, 0xe8, 0xed, 0xb0,
-	0x5c, 0x32, 0x85, 0x96, 0x91, 0x39, 0x62, 0x04, 0x01, 0x75, 0x28, 0x41, 0x9f, 0x92, 0xf8, 0x31,
-	0x74, 0x95, 0xfe, 0x08, 0x52, 0x77, 0x53, 0x68, 0xba, 0xe4, 0x3a, 0x56, 0xd1, 0x50, 0x31, 0xd7,
-	0x19, 0xe5, 0x01, 0xea, 0x51, 0xca, 0x54, 0x0a, 0x8c, 0xfa, 0x67, 0x00, 0x79, 0xd1, 0x3f, 0x97,
-	0x42, 0xa9, 0x27, 0x91, 0x4b, 0xa1, 0xdc, 0x23, 0x90, 0xea, 0x81, 0x80, 0x92, 0x16, 0x35, 0xb4,
-	0xc7, 0x30, 0xd0, 0x3b, 0x2d, 0xe8, 0x25, 0x95, 0x42, 0xa9, 0x35, 0x33, 0x3d, 0xbb, 0x6c, 0x5a,
-	0xd7, 0x19, 0x34, 0x60, 0x3a, 0x93, 0x93, 0xdd, 0x87, 0xd5, 0xac, 0x5b, 0x80, 0x26, 0x2a, 0x11,
-	0xb5, 0xa9, 0x30, 0x3d, 0x55, 0x31, 0x23, 0x28, 0x8f, 0x19, 0xe5, 0x2e, 0x5a, 0xa5, 0x94, 0x79,
-	0x75, 0x49, 0x12, 0x65, 0x7d, 0x43, 0x9d, 0xa8, 0xd2, 0x6a, 0x28, 0x10, 0x55, 0x1b, 0x0e, 0x05,
-	0xa2, 0x8c, 0xce, 0xe7, 0x5c, 0xd6, 0xbc, 0x69, 0xa0, 0xcb, 0x5a, 0xeb, 0x39, 0xe8, 0xb2, 0xd6,
-	0x7b, 0x0c, 0xe6, 0x29, 0x46, 0x77, 0xcd, 0x64, 0x62, 0x08, 0xfc, 0x38, 0xe1, 0x4d, 0x05, 0xaa,
-	0xd1, 0x36, 0x74, 0x95, 0x0a, 0x77, 0xae, 0x29, 0xe5, 0x62, 0x7c, 0xae, 0x29, 0x55, 0x25, 0xf1,
-	0x93, 0x8c, 0xc5, 0x98, 0x5b, 0xa3, 0x70, 0x81, 0x89, 0xc8, 0x95, 0xd1, 0x4f, 0x00, 0xf2, 0xfa,
-	0x44, 0x7e, 0x80, 0x52, 0xe5, 0x2a, 0x57, 0xef, 0x42, 0x39, 0x43, 0xdf, 0x3d, 0xab, 0x19, 0x31,
-	0x75, 0xb9, 0x6e, 0x5c, 0xbc, 0x62, 0xa0, 0x47, 0x30, 0xc8, 0xf1, 0xf7, 0x8f, 0x89, 0xfb, 0x2c,
-	0x16, 0xd3, 0xaa, 0x29, 0x71, 0x80, 0x97, 0x18, 0x97, 0x93, 0x26, 0xd2, 0xb9, 0xc4, 0xc7, 0xc4,
-	0xa5, 0x72, 0xfa, 0x11, 0x74, 0x95, 0x7f, 0x00, 0x72, 0x39, 0x95, 0x7f, 0x0c, 0x98, 0x56, 0xd5,
-	0x4b, 0x74, 0x6b, 0x8d, 0xf9, 0xa2, 0xf8, 0xc8, 0x59, 0x50, 0xda, 0x04, 0x06, 0x7a, 0xa2, 0x9f,
-	0xab, 0x7d, 0x65, 0xd5, 0x20, 0x57, 0xfb, 0x25, 0xf5, 0x01, 0xed, 0x2c, 0xac, 0x8a, 0x8b, 0x55,
-	0xef, 0x30, 0xa3, 0x0e, 0x31, 0x4b, 0xf8, 0x55, 0x87, 0x58, 0xac, 0x29, 0xa8, 0x0e, 0xb1, 0x54,
-	0x21, 0xd0, 0xcf, 0xc4, 0xd9, 0xc8, 0x9b, 0xa1, 0x7a, 0x9b, 0xa7, 0xfb, 0xf9, 0x9d, 0x94, 0x2a,
-	0x06, 0xd3, 0x69, 0xd5, 0x54, 0x95, 0xde, 0x72, 0x06, 0xd2, 0x1b, 0x7d, 0x0a, 0x1d, 0x99, 0x5e,
-	0xa2, 0x4c, 0x73, 0x0a, 0x39, 0xe8, 0x74, 0x52, 0x9e, 0x28, 0xa8, 0x2b, 0x33, 0x6c, 0xb1, 0x98,
-	0xa5, 0x74, 0x31, 0x0c, 0x0b, 0x29, 0x2a, 0xca, 0xa4, 0x5d, 0x9d, 0xbb, 0x4e, 0xf5, 0xa6, 0x3f,
-	0x2f, 0xcc, 0x9b, 0xa7, 0x19, 0x83, 0x0d, 0xb4, 0xc6, 0x18, 0xc8, 0x85, 0x5c, 0xa5, 0xae, 0x18,
-	0x68, 0x06, 0x03, 0x3d, 0xa7, 0xcd, 0xaf, 0xbc, 0x32, 0xd7, 0x7d, 0xa6, 0x52, 0x21, 0xa4, 0x31,
-	0xa1, 0x6a, 0x45, 0x79, 0x2c, 0x0a, 0x79, 0xb3, 0x48, 0x80, 0x5f, 0x8c, 0x95, 0x58, 0x64, 0xbe,
-	0xc2, 0x58, 0x9d, 0x46, 0xa7, 0x4a, 0xac, 0x64, 0x29, 0xf2, 0x8a, 0x81, 0x1e, 0x17, 0x33, 0x9c,
-	0x33, 0x4b, 0x42, 0xf2, 0x82, 0x2b, 0xae, 0x0c, 0xd8, 0xe5, 0xed, 0x23, 0xe6, 0x8a, 0x13, 0x8e,
-	0xc2, 0xe3, 0x76, 0xf4, 0x11, 0x34, 0x59, 0x34, 0x8c, 0xd6, 0xf3, 0x40, 0x2b, 0x0f, 0xba, 0xa7,
-	0x1b, 0x05, 0xa8, 0xee, 0xcd, 0x4c, 0x66, 0x5e, 0x53, 0xc2, 0x63, 0x92, 0x59, 0x8b, 0xfd, 0xf5,
-	0xf8, 0xd6, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x70, 0x51, 0x5f, 0xf0, 0x20, 0x29, 0x00, 0x00,
+	// 3477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x5d, 0x8f, 0xdd, 0xc8,
+	0x52, 0xe3, 0xf3, 0x31, 0x73, 0xa6, 0xce, 0xc7, 0x9c, 0xd3, 0xf3, 0x75, 0xe2, 0x64, 0x73, 0xb3,
+	0x26, 0xbb, 0x64, 0x73, 0xb3, 0x93, 0x30, 0xcb, 0x92, 0x9b, 0x5c, 0x76, 0x75, 0x33, 0x93, 0x61,
+	0x93, 0xbb, 0x93, 0x0f, 0x79, 0x36, 0xf7, 0x06, 0x04, 0xd7, 0xf2, 0xb1, 0x3b, 0x19, 0x13, 0x8f,
+	0x7d, 0xe2, 0x8f, 0x99, 0x0c, 0x4f, 0x68, 0x97, 0x27, 0x5e, 0x97, 0x47, 0x24, 0x78, 0xe2, 0x05,
+	0x5e, 0x11, 0x12, 0x7f, 0x03, 0x09, 0x78, 0x40, 0x42, 0x48, 0xfc, 0x03, 0xfe, 0x00, 0xaa, 0xfe,
+	0xb0, 0xbb, 0x6d, 0x9f, 0x6c, 0xb2, 0x82, 0x37, 0x77, 0x55, 0x75, 0x55, 0x77, 0x75, 0x75, 0x7d,
+	0xb5, 0x61, 0xf0, 0x26, 0xf7, 0x93, 0xb9, 0xb7, 0x33, 0x4f, 0xe2, 0x2c, 0x26, 0xcb, 0x7c, 0x64,
+	0x4e, 0xdc, 0x28, 0x8a, 0x33, 0x37, 0x0b, 0xe2, 0x28, 0xe5, 0x28, 0x6b, 0x13, 0xd6, 0xef, 0xf9,
+	0xfe, 0x7e, 0x9e, 0x24, 0x34, 0xf2, 0xce, 0x6d, 0x9a, 0xce, 0xe3, 0x28, 0xa5, 0xd6, 0x6f, 0x60,
+	0x74, 0xcf, 0xf7, 0x9f, 0xba, 0x41, 0x62, 0xd3, 0xd7, 0x39, 0x4d, 0x33, 0x72, 0x15, 0x86, 0x33,
+	0x37, 0xa5, 0x8e, 0x27, 0x48, 0xa7, 0xc6, 0x15, 0xe3, 0xda, 0xaa, 0xad, 0x03, 0xc9, 0xc7, 0x30,
+	0x7a, 0x9d, 0xc7, 0x99, 0x42, 0xd6, 0x62, 0x64, 0x15, 0xa8, 0x35, 0x81, 0xb5, 0x82, 0xbf, 0x10,
+	0xf9, 0x4f, 0x2d, 0x58, 0xd9, 0x73, 0x43, 0x37, 0xf2, 0x28, 0x0a, 0xcb, 0xe2, 0xcc, 0x0d, 0x9d,
+	0x19, 0x07, 0x30, 0x61, 0x1d, 0x5b, 0x07, 0x92, 0x6b, 0xb0, 0xe6, 0x1d, 0xbb, 0x51, 0x44, 0x4b,
+	0xba, 0x16, 0xa3, 0xab, 0x82, 0xc9, 0xcf, 0x60, 0x7b, 0x4e, 0x23, 0x3f, 0x88, 0x5e, 0x3a, 0xd5,
+	0x19, 0x6d, 0x36, 0x63, 0x11, 0x9a, 0xdc, 0x85, 0x69, 0x10, 0xb9, 0x5e, 0x16, 0x9c, 0xd2, 0xda,
+	0xd4, 0x0e, 0x9b, 0xba, 0x10, 0x8f, 0xca, 0x38, 0x73, 0xc3, 0x90, 0x66, 0xc5, 0x8c, 0x2e, 0x9b,
+	0x51, 0x81, 0x92, 0x2f, 0xc1, 0xcc, 0x23, 0x2f, 0x8e, 0x5e, 0x04, 0xc9, 0x09, 0xf5, 0x9d, 0xca,
+	0x9c, 0x65, 0x36, 0xe7, 0x2d, 0x14, 0xd6, 0xef, 0x01, 0xec, 0xb9, 0x91, 0x3c, 0xa8, 0x6b, 0xb0,
+	0x16, 0xc5, 0x3e, 0x75, 0x02, 0x9f, 0x46, 0x59, 0xf0, 0x22, 0xa0, 0x89, 0x38, 0xaa, 0x2a, 0xd8,
+	0x1a, 0x42, 0x9f, 0xcd, 0x13, 0x07, 0x70, 0x1b, 0xba, 0xfb, 0xc7, 0x6e, 0x10, 0x91, 0x0d, 0xe8,
+	0x7a, 0xf8, 0x21, 0xe6, 0xf1, 0x01, 0x99, 0xc2, 0x4a, 0x44, 0xb3, 0xb3, 0x38, 0x79, 0x25, 0xce,
+	0x54, 0x0e, 0xad, 0x39, 0xf4, 0xf6, 0xf9, 0xd6, 0x53, 0xb2, 0x05, 0xcb, 0x5c, 0x1b, 0x6c, 0xf2,
+	0xd0, 0x16, 0x23, 0x62, 0x42, 0x4f, 0xea, 0x89, 0x4d, 0x1f, 0xda, 0xc5, 0x18, 0x39, 0x0b, 0xf5,
+	0xb3, 0xd3, 0x18, 0xda, 0x72, 0x88, 0xdc, 0xbc, 0x30, 0x4e, 0xa9, 0xcf, 0x74, 0x3d, 0xb4, 0xc5,
+	0xc8, 0x7a, 0x0d, 0xeb, 0xfb, 0xf8, 0x25, 0xc4, 0xbe, 0xf7, 0xd6, 0x71, 0x39, 0x15, 0x0b, 0x2d,
+	0xc6, 0xb8, 0xfd, 0x17, 0x71, 0x22, 0x4c, 0xa3, 0x67, 0xf3, 0x81, 0xb5, 0x05, 0x1b, 0xba, 0x48,
+	0xa1, 0xb5, 0x1b, 0x30, 0xda, 0x8f, 0xa3, 0x88, 0x7a, 0x99, 0x5c, 0x85, 0x09, 0x3d, 0x26, 0x2e,
+	0x4f, 0x02, 0x21, 0xbe, 0x18, 0xa3, 0xdd, 0x17, 0xd4, 0x82, 0xc1, 0x4d, 0x98, 0xec, 0x27, 0xd4,
+	0xcd, 0xe8, 0xe3, 0xd8, 0xa7, 0x0a, 0x8f, 0xb9, 0x9b, 0xa6, 0x67, 0x71, 0xe2, 0x4b, 0x1e, 0x72,
+	0x6c, 0x7d, 0x6f, 0x00, 0x51, 0x67, 0x70, 0x3e, 0xe4, 0xb7, 0x60, 0x98, 0x52, 0xea, 0x3b, 0x27,
+	0x11, 0x3d, 0x89, 0xa3, 0xc0, 0x9b, 0x1a, 0x57, 0xda, 0xd7, 0x56, 0xed, 0x01, 0x02, 0x1f, 0x09,
+	0x18, 0xf9, 0x04, 0xc6, 0x41, 0x14, 0x64, 0x81, 0x1b, 0x06, 0x7f, 0x46, 0x7d, 0x27, 0x8c, 0xfc,
+	0x74, 0xda, 0x62, 0x74, 0x6b, 0x0a, 0xfc, 0x30, 0xf2, 0x53, 0xf2, 0x29, 0x10, 0x95, 0x34, 0x71,
+	0x51, 0x7d, 0x42, 0x27, 0x13, 0x05, 0x63, 0x33, 0x84, 0xf5, 0xaf, 0x06, 0xf4, 0xa4, 0x1b, 0xd1,
+	0xd4, 0x6b, 0x54, 0xd4, 0xfb, 0x05, 0xf4, 0xd3, 0x33, 0x77, 0xee, 0x78, 0x61, 0x40, 0xa3, 0x8c,
+	0x69, 0x7f, 0xb4, 0x7b, 0x71, 0x47, 0x38, 0x2c, 0xc9, 0x62, 0xe7, 0xe8, 0xcc, 0x9d, 0xef, 0x33,
+	0x12, 0x5b, 0xa5, 0xe7, 0xae, 0xe1, 0x15, 0x8d, 0x1c, 0xd7, 0xf7, 0x13, 0x9a, 0xa6, 0x6c, 0x45,
+	0xab, 0xb6, 0x0e, 0xc4, 0xab, 0xe7, 0x53, 0x2f, 0x38, 0x71, 0x43, 0x67, 0x1e, 0xba, 0x1e, 0x4d,
+	0x85, 0x01, 0x55, 0xa0, 0xd6, 0x87, 0x00, 0xa5, 0x20, 0xb2, 0x02, 0xed, 0xc3, 0xc7, 0xf7, 0xc7,
+	0x4b, 0x04, 0x60, 0xd9, 0xbe, 0xf7, 0xf0, 0xfe, 0xc1, 0xe3, 0xb1, 0x61, 0xfd, 0x02, 0x36, 0xee,
+	0x07, 0xa9, 0x17, 0x9f, 0xd2, 0x04, 0xf5, 0x9d, 0xbe, 0xff, 0x3d, 0xfb, 0x1c, 0x36, 0x2b, 0x1c,
+	0xc4, 0x91, 0x5d, 0x82, 0xd5, 0x28, 0x3f, 0x71, 0x90, 0x3e, 0x15, 0xf7, 0xa5, 0x04, 0x58, 0x7f,
+	0x69, 0x00, 0x39, 0x78, 0x43, 0xbd, 0x3c, 0xa3, 0xb8, 0x46, 0xc5, 0x34, 0xe2, 0xc4, 0xa7, 0x89,
+	0x13, 0x14, 0xa6, 0x21, 0xc7, 0xec, 0x26, 0xb9, 0x01, 0x43, 0x89, 0x3b, 0x2a, 0x86, 0xc4, 0x82,
+	0xc1, 0x9c, 0xd2, 0xc4, 0x99, 0xe7, 0x33, 0xe7, 0x15, 0x3d, 0x17, 0x5a, 0xd3, 0x60, 0xc8, 0xf9,
+	0x75, 0xee, 0x46, 0x59, 0x90, 0x9d, 0x0b, 0xdf, 0x56, 0x8c, 0xd1, 0x4a, 0xbf, 0xa2, 0x99, 0xf0,
+	0xcf, 0xca, 0x52, 0x16, 0x1d, 0xb3, 0xf5, 0x77, 0x06, 0x10, 0x75, 0x86, 0xd8, 0xf2, 0x1e, 0xf4,
+	0x84, 0xdb, 0x4a, 0x99, 0x81, 0xf6, 0x77, 0xaf, 0xc9, 0xa3, 0xaf, 0x53, 0xef, 0x88, 0x71, 0x7a,
+	0x10, 0x65, 0xc9, 0xb9, 0xbd, 0xcc, 0xf6, 0x99, 0x9a, 0x87, 0x30, 0xd4, 0x10, 0x64, 0x0c, 0xed,
+	0x57, 0x54, 0x2e, 0x01, 0x3f, 0xc9, 0x47, 0xd0, 0x3d, 0x75, 0xc3, 0x9c, 0xfb, 0x9a, 0xfe, 0xee,
+	0x9a, 0x94, 0x21, 0x05, 0x70, 0xec, 0xdd, 0xd6, 0xcf, 0x0c, 0x6b, 0x0c, 0xa3, 0xaf, 0x68, 0xf6,
+	0x30, 0x7a, 0x11, 0x8b, 0x6d, 0x59, 0xff, 0xd6, 0x86, 0xb5, 0x02, 0x24, 0xd6, 0x3d, 0x85, 0x95,
+	0x53, 0x9a, 0xa4, 0x41, 0x2c, 0xbd, 0xa2, 0x1c, 0xa2, 0x66, 0xd9, 0x81, 0x4b, 0xcd, 0x72, 0xc5,
+	0x6b, 0x30, 0x42, 0xa0, 0x93, 0x27, 0x01, 0xda, 0x2a, 0x5e, 0x35, 0xf6, 0x2d, 0x0f, 0x1f, 0x4f,
+	0x40, 0x5a, 0x67, 0x09, 0x28, 0xb0, 0x6e, 0x90, 0xa4, 0xd3, 0xae, 0x82, 0x45, 0x00, 0xf9, 0x29,
+	0x08, 0x5d, 0xb0, 0xe8, 0xd0, 0xdf, 0x5d, 0x97, 0xfb, 0x7b, 0xc2, 0xa0, 0xfb, 0x71, 0x1e, 0x65,
+	0x52, 0x5d, 0x64, 0x17, 0xda, 0x61, 0xe4, 0x4f, 0x57, 0x98, 0xb6, 0xaf, 0x28, 0xda, 0x56, 0x37,
+	0xb8, 0x73, 0x18, 0xf9, 0x5c, 0xcb, 0x48, 0x4c, 0xae, 0xc3, 0xb2, 0xb8, 0xf0, 0x3d, 0x26, 0x80,
+	0xc8, 0x69, 0xfc, 0xb6, 0xb3, 0x99, 0x82, 0x02, 0xfd, 0xa5, 0x1b, 0x06, 0x6e, 0x3a, 0x5d, 0xe5,
+	0xe1, 0x82, 0x0d, 0xd4, 0x70, 0x01, 0x5a, 0xb8, 0x20, 0xb7, 0x60, 0x5d, 0x46, 0x5b, 0x76, 0xb1,
+	0x8f, 0xdd, 0xf4, 0x98, 0xa6, 0xd3, 0x3e, 0xd3, 0x4d, 0x13, 0xca, 0xfc, 0x0a, 0x7a, 0x72, 0x79,
+	0xef, 0x71, 0xd6, 0x87, 0x91, 0xcf, 0xd6, 0xa9, 0x9c, 0xf5, 0x97, 0xcc, 0x26, 0xf1, 0x12, 0x2a,
+	0xe7, 0xfd, 0x1e, 0x37, 0xd9, 0x86, 0x75, 0x6d, 0x7e, 0xe1, 0x7a, 0xd7, 0x12, 0x3a, 0xcf, 0x79,
+	0x66, 0x75, 0xe4, 0xc5, 0x09, 0x8f, 0x7e, 0x13, 0x1b, 0x4a, 0x30, 0xc6, 0xb2, 0x19, 0x86, 0x0e,
+	0x7e, 0x35, 0x7b, 0xb6, 0x18, 0x59, 0xdb, 0xb0, 0x79, 0x18, 0xa4, 0x99, 0x70, 0x7c, 0x41, 0xe1,
+	0x60, 0xac, 0x5f, 0xc2, 0x56, 0x15, 0x21, 0xe4, 0xdd, 0x02, 0xf0, 0x0a, 0xa8, 0xb8, 0x46, 0xe3,
+	0xaa, 0x07, 0xb5, 0x15, 0x1a, 0xeb, 0x6f, 0x0d, 0x98, 0x20, 0x33, 0x6e, 0x1f, 0x72, 0xe3, 0x8a,
+	0xbb, 0x30, 0x74, 0x77, 0xf1, 0x39, 0x74, 0xe3, 0xb3, 0x88, 0x26, 0xc2, 0x3d, 0xff, 0xa4, 0xd0,
+	0x69, 0x95, 0xc7, 0xce, 0x13, 0x24, 0xb3, 0x39, 0x35, 0x9a, 0x42, 0x18, 0x9c, 0x04, 0x99, 0x88,
+	0xe3, 0x7c, 0x60, 0x5d, 0x85, 0x2e, 0xa3, 0x22, 0x3d, 0xe8, 0xec, 0x3d, 0xf9, 0xe6, 0xc1, 0x78,
+	0x09, 0x3d, 0xed, 0x93, 0x5f, 0x3f, 0x1e, 0x1b, 0x08, 0x7a, 0x7a, 0x70, 0x60, 0x8f, 0x5b, 0xd6,
+	0xdf, 0x18, 0x40, 0x54, 0xf6, 0x62, 0xaf, 0x5f, 0x16, 0xa6, 0xce, 0xf7, 0xf9, 0x71, 0xd3, 0x52,
+	0x84, 0x0d, 0xf3, 0xa1, 0xee, 0x2c, 0x1e, 0x42, 0x5f, 0x01, 0x37, 0x98, 0xcf, 0x55, 0xdd, 0x7c,
+	0x46, 0xfa, 0x55, 0x52, 0xad, 0x87, 0xc0, 0x18, 0x85, 0x62, 0xd6, 0x5a, 0x1c, 0xd2, 0x27, 0x30,
+	0x51, 0x60, 0x62, 0xcd, 0x1b, 0xd0, 0xe5, 0x17, 0x97, 0x87, 0x60, 0x3e, 0x28, 0xa6, 0xd3, 0x52,
+	0x7b, 0xd6, 0x6d, 0x98, 0x28, 0x30, 0x31, 0xdd, 0x82, 0x2e, 0xf7, 0x0a, 0x7c, 0xc7, 0x03, 0xb9,
+	0x22, 0xa4, 0xb2, 0x39, 0x4a, 0xca, 0xfd, 0x26, 0x71, 0x95, 0x90, 0x54, 0xa8, 0x1f, 0xb7, 0xd7,
+	0x95, 0xea, 0xff, 0x39, 0x10, 0x95, 0x54, 0x08, 0xf9, 0x08, 0x96, 0x33, 0x06, 0x11, 0x52, 0x86,
+	0x52, 0x0a, 0xa3, 0xb3, 0x05, 0xd2, 0xfa, 0x0f, 0x03, 0x56, 0xc4, 0x45, 0x42, 0x0b, 0x4e, 0x33,
+	0x37, 0xcb, 0x53, 0xa1, 0x3e, 0x31, 0x22, 0x37, 0xa0, 0x27, 0x52, 0xdf, 0x54, 0x28, 0xb1, 0x34,
+	0x46, 0x01, 0xb7, 0x0b, 0x0a, 0x14, 0xcc, 0x12, 0x4a, 0xee, 0x0d, 0x15, 0xc1, 0x2c, 0xf9, 0xb4,
+	0x05, 0x92, 0x5c, 0x81, 0xfe, 0x2c, 0x8c, 0xbd, 0x57, 0xc7, 0x34, 0x78, 0x79, 0x9c, 0x09, 0x07,
+	0xa9, 0x82, 0x0a, 0xa7, 0xda, 0x55, 0x9c, 0xaa, 0xe2, 0xa6, 0x97, 0x75, 0x37, 0x5d, 0x78, 0xa9,
+	0x15, 0xc5, 0x4b, 0x59, 0x7f, 0x65, 0x00, 0x79, 0x32, 0xa7, 0xd1, 0xff, 0x4b, 0x22, 0x89, 0xb9,
+	0xf0, 0x09, 0xba, 0x62, 0x76, 0x1d, 0xda, 0xb6, 0x18, 0xe1, 0xd6, 0xe6, 0x79, 0x7a, 0xec, 0x08,
+	0x64, 0x87, 0x21, 0x55, 0x10, 0x56, 0x65, 0xda, 0xaa, 0x44, 0xaa, 0xf8, 0x8f, 0x2d, 0xe8, 0x32,
+	0xb3, 0x64, 0x16, 0x96, 0x04, 0xa2, 0x30, 0x32, 0x6c, 0x3e, 0xd0, 0x02, 0x78, 0x4b, 0x0f, 0xe0,
+	0xea, 0x5d, 0x6f, 0xeb, 0x77, 0x7d, 0x04, 0xad, 0x80, 0x27, 0xd8, 0xab, 0x76, 0x2b, 0xf0, 0xc9,
+	0xd5, 0x4a, 0xaa, 0x80, 0xd1, 0x67, 0xf5, 0xc1, 0x52, 0x25, 0x59, 0xb8, 0x04, 0xbd, 0x30, 0xf6,
+	0xdc, 0x10, 0x19, 0x2e, 0x0b, 0x8a, 0x02, 0x42, 0x2e, 0x03, 0x78, 0x2c, 0x45, 0xf5, 0x1d, 0x37,
+	0x63, 0x2a, 0xef, 0xd8, 0x0a, 0x84, 0x7c, 0x04, 0x9d, 0x34, 0xf0, 0x29, 0x8b, 0x2e, 0xa3, 0xdd,
+	0x89, 0x76, 0xe7, 0x8e, 0x02, 0x9f, 0xda, 0x0c, 0x8d, 0xb1, 0x35, 0x48, 0x9d, 0xf8, 0x2c, 0x72,
+	0xd8, 0x6d, 0x66, 0x11, 0xa6, 0x67, 0x6b, 0x30, 0x34, 0x83, 0xe3, 0x38, 0xf4, 0x59, 0x94, 0xe9,
+	0xd8, 0xec, 0x7b, 0x6f, 0x08, 0x7d, 0x4e, 0xc0, 0xa2, 0x29, 0x7a, 0xbf, 0x01, 0x63, 0x6d, 0xd3,
+	0x93, 0xf8, 0xd4, 0x0d, 0x35, 0x45, 0x19, 0x8b, 0x15, 0x55, 0xc9, 0xa1, 0xd4, 0xcc, 0xab, 0x5d,
+	0xc9, 0xbc, 0x4c, 0x45, 0x1d, 0x5c, 0x95, 0xa5, 0x32, 0xaa, 0xbb, 0xe8, 0xd6, 0x77, 0x61, 0x1d,
+	0xc3, 0x32, 0x77, 0x38, 0xe4, 0x53, 0x80, 0x59, 0x7e, 0xee, 0x68, 0x4e, 0x6f, 0xa8, 0x29, 0xc8,
+	0x56, 0x08, 0xc8, 0x4d, 0xe8, 0xa7, 0x34, 0x0c, 0x25, 0x7d, 0xab, 0x89, 0x5e, 0xa5, 0xb0, 0x3e,
+	0x93, 0x0e, 0x91, 0x65, 0x09, 0xa8, 0x3e, 0xd4, 0x91, 0x48, 0x3f, 0xd9, 0x37, 0x3a, 0xc9, 0xf8,
+	0x2c, 0x12, 0x75, 0x1a, 0x7e, 0x5a, 0xdf, 0x1a, 0x62, 0xd6, 0xb3, 0xb9, 0xef, 0x66, 0xe8, 0x3d,
+	0xba, 0x7c, 0x2f, 0xc6, 0x15, 0xa3, 0x26, 0xef, 0xc1, 0x92, 0xcd, 0xb1, 0xe4, 0xf7, 0x61, 0xc8,
+	0x35, 0x94, 0x70, 0xc5, 0x0b, 0xf7, 0xb0, 0xa1, 0x2f, 0x8f, 0xe3, 0x1e, 0x2c, 0xd9, 0x3a, 0xf1,
+	0xde, 0x08, 0x06, 0x1c, 0x90, 0x33, 0xa1, 0xd6, 0x77, 0x6d, 0xe8, 0xa0, 0x0f, 0xc4, 0x23, 0x92,
+	0xd9, 0xbf, 0x88, 0x5b, 0x62, 0xf8, 0x4e, 0xc9, 0xd8, 0x2f, 0x60, 0x10, 0x46, 0xbe, 0x1c, 0x4a,
+	0x37, 0x74, 0x49, 0xf5, 0xb2, 0x98, 0x3b, 0x3c, 0xcd, 0x67, 0x5f, 0xd3, 0x73, 0x11, 0x4d, 0xb4,
+	0x19, 0x28, 0x3f, 0x88, 0x66, 0x71, 0x1e, 0xf1, 0xb3, 0xee, 0xd9, 0x72, 0x58, 0x7a, 0xfe, 0xae,
+	0xe2, 0xf9, 0xf1, 0xc2, 0xbf, 0xc9, 0x7d, 0x47, 0xf7, 0x4c, 0x2a, 0x88, 0xdc, 0x80, 0x49, 0x4a,
+	0xbd, 0x38, 0xf2, 0x53, 0xc7, 0xe3, 0xf5, 0x21, 0xf5, 0xd9, 0xb5, 0x19, 0xda, 0x75, 0x04, 0x56,
+	0x37, 0x3c, 0xf7, 0x2a, 0x8a, 0xa0, 0x1e, 0xef, 0xb2, 0xe8, 0xd0, 0xe6, 0xcc, 0xcc, 0xfc, 0x02,
+	0xd6, 0x2a, 0xdb, 0x6b, 0x88, 0x8a, 0x1b, 0x6a, 0x54, 0x5c, 0x55, 0xa3, 0xe0, 0x9f, 0xb7, 0x60,
+	0xf2, 0x14, 0xab, 0x27, 0x71, 0x78, 0x45, 0xe8, 0xf9, 0x3f, 0x73, 0x48, 0xea, 0x3d, 0xeb, 0x54,
+	0xee, 0x99, 0x74, 0x1c, 0xdd, 0xb7, 0x3b, 0x8e, 0xeb, 0x30, 0x4e, 0x28, 0xab, 0xf1, 0x9c, 0x82,
+	0x15, 0x57, 0x7b, 0x0d, 0x8e, 0xf9, 0x68, 0x70, 0x72, 0x42, 0xfd, 0xc0, 0xcd, 0x10, 0xea, 0x78,
+	0x58, 0x21, 0x84, 0x4c, 0xfb, 0x3d, 0xbb, 0x09, 0x85, 0x2a, 0x20, 0xaa, 0x0a, 0x44, 0x48, 0xbd,
+	0x83, 0xc5, 0x75, 0x46, 0x93, 0xc8, 0x0d, 0x9d, 0x13, 0x37, 0xf3, 0x8e, 0xe9, 0x82, 0xfb, 0x5b,
+	0x23, 0x23, 0x3f, 0x87, 0x11, 0x4b, 0x78, 0xd3, 0xdc, 0xf3, 0x68, 0x9a, 0x52, 0x79, 0x91, 0x8b,
+	0xc4, 0x1e, 0x2b, 0xc0, 0x23, 0x8e, 0xb4, 0x2b, 0xa4, 0xe4, 0x36, 0xa6, 0x9f, 0x27, 0x6e, 0x10,
+	0x61, 0xde, 0xcc, 0xaf, 0x65, 0xbb, 0xe1, 0x5a, 0xda, 0x55, 0x2a, 0x72, 0x07, 0x86, 0x8c, 0xd5,
+	0x0b, 0x37, 0x08, 0xf3, 0x84, 0x15, 0xc9, 0x35, 0xa1, 0x7f, 0xc0, 0x71, 0xb6, 0x4e, 0x69, 0xfd,
+	0x45, 0x0b, 0xd6, 0x4a, 0x15, 0x1c, 0x9c, 0x62, 0xf9, 0x7c, 0x1b, 0x46, 0xfa, 0xc6, 0x16, 0x79,
+	0x87, 0x0a, 0x19, 0xb9, 0x03, 0x03, 0x75, 0x4b, 0xc2, 0x4b, 0x34, 0xed, 0x1d, 0xc3, 0x90, 0x4a,
+	0x4a, 0xee, 0xbc, 0xdb, 0xde, 0x1f, 0x2c, 0x35, 0xed, 0x7e, 0xa0, 0xee, 0x69, 0xda, 0xa9, 0x4b,
+	0x15, 0x9b, 0x2f, 0xa4, 0x0a, 0xd2, 0xbd, 0x15, 0xe8, 0x52, 0xdc, 0xb2, 0xf5, 0xd7, 0x06, 0x40,
+	0x59, 0x12, 0x2d, 0xcc, 0x90, 0x14, 0x87, 0xd5, 0xd2, 0x1d, 0x96, 0x9a, 0x3b, 0xb5, 0x7f, 0x30,
+	0x77, 0x52, 0xd2, 0x9b, 0x4e, 0x2d, 0xbd, 0xe1, 0x3d, 0xbb, 0xae, 0xd2, 0xb3, 0xb3, 0x3e, 0x83,
+	0x4d, 0xe6, 0x5d, 0x69, 0xd9, 0xe0, 0xfd, 0xe1, 0xca, 0x7d, 0x0a, 0x5b, 0xd5, 0x49, 0x22, 0xff,
+	0x38, 0x04, 0xc2, 0x31, 0xda, 0xd5, 0x7f, 0x5b, 0x43, 0xe2, 0x2d, 0x0e, 0xc0, 0xfa, 0x1c, 0xd6,
+	0x35, 0x6e, 0xe2, 0x16, 0x5d, 0x86, 0xb1, 0x24, 0x71, 0xe2, 0xc8, 0x61, 0xb1, 0xdd, 0x28, 0x63,
+	0xbb, 0xf5, 0x29, 0x4c, 0xf8, 0x34, 0xb5, 0x3b, 0xbd, 0xb0, 0x92, 0xb1, 0x36, 0x80, 0xa8, 0xe4,
+	0x62, 0x27, 0xff, 0xd9, 0x42, 0x70, 0x9a, 0xc5, 0x89, 0xd6, 0x76, 0x7b, 0xa7, 0x1e, 0x9a, 0xda,
+	0x9b, 0x6b, 0xe9, 0xbd, 0x39, 0xf2, 0x35, 0xf4, 0x31, 0x52, 0xcc, 0x5c, 0xef, 0x55, 0x3e, 0x97,
+	0xa1, 0xe5, 0x7a, 0x51, 0x3c, 0xd7, 0x24, 0x62, 0xa0, 0xd9, 0xe3, 0xc4, 0x3c, 0xd0, 0x40, 0x58,
+	0x00, 0xc8, 0x6f, 0xc3, 0x9a, 0x70, 0xe8, 0xbe, 0x9b, 0xb9, 0xd8, 0x68, 0x67, 0xa7, 0x3e, 0x90,
+	0x7e, 0xfe, 0xbe, 0x80, 0x92, 0x5b, 0xb0, 0x51, 0x21, 0x74, 0xe6, 0x6e, 0x76, 0x2c, 0x6c, 0x81,
+	0xe8, 0xd4, 0x4f, 0xdd, 0xec, 0x98, 0x7c, 0xc8, 0x5e, 0x08, 0x4a, 0xbe, 0xcb, 0x8c, 0x2f, 0x86,
+	0x24, 0x49, 0x26, 0xc2, 0x84, 0xba, 0xb8, 0x1f, 0x0a, 0x13, 0x03, 0x35, 0x4c, 0x78, 0xb0, 0xae,
+	0x6d, 0xb7, 0xec, 0x52, 0x26, 0x1c, 0x2c, 0xba, 0x8f, 0x42, 0xc3, 0x12, 0xc8, 0x5a, 0x8f, 0xb8,
+	0x71, 0x49, 0x24, 0xda, 0x10, 0xbc, 0x66, 0x1e, 0x49, 0xb0, 0x68, 0x3a, 0x4e, 0x60, 0xed, 0xe8,
+	0x38, 0xcf, 0xfc, 0xf8, 0x4c, 0xb6, 0xbf, 0xb1, 0xca, 0x2a, 0x41, 0xe2, 0xb4, 0x7f, 0x17, 0xb6,
+	0x8e, 0xf2, 0x59, 0xea, 0x25, 0xc1, 0x8c, 0xea, 0x15, 0xb0, 0x09, 0x3d, 0xfa, 0x26, 0x48, 0x33,
+	0xec, 0x3d, 0x1b, 0x4c, 0x44, 0x31, 0xb6, 0xbe, 0x80, 0xcd, 0x62, 0x16, 0x3a, 0x83, 0x54, 0x79,
+	0x0a, 0x09, 0x22, 0x2f, 0xcc, 0x7d, 0xea, 0x64, 0xee, 0x2b, 0x91, 0x04, 0xf5, 0x6c, 0x1d, 0x68,
+	0xfd, 0xbd, 0x01, 0x7d, 0xc5, 0x87, 0xfc, 0xc8, 0xbe, 0x9d, 0x7a, 0x81, 0xda, 0x95, 0x08, 0x5a,
+	0xed, 0xe9, 0x75, 0x1a, 0x7a, 0x7a, 0x1f, 0xc3, 0x48, 0x38, 0x2d, 0x27, 0xa1, 0x6e, 0x1a, 0x4b,
+	0x07, 0x51, 0x81, 0x5a, 0xff, 0xde, 0x86, 0xbe, 0xe2, 0x67, 0xc9, 0x85, 0xda, 0x6a, 0x57, 0xd8,
+	0xf8, 0xa1, 0x9e, 0xea, 0xb6, 0x2a, 0xa9, 0xee, 0x5b, 0x83, 0xfa, 0xa2, 0xe6, 0x22, 0xba, 0xcd,
+	0x84, 0xf5, 0x7a, 0xc4, 0xe2, 0xc4, 0x08, 0xcb, 0x30, 0x5e, 0x10, 0x39, 0x09, 0xf5, 0x68, 0x70,
+	0x4a, 0x7d, 0x96, 0xe8, 0x74, 0xec, 0x2a, 0x18, 0x33, 0x2c, 0x01, 0x4a, 0xb1, 0xa9, 0xbc, 0xca,
+	0xa8, 0x54, 0x50, 0x4d, 0x59, 0xd0, 0xa0, 0xac, 0x1b, 0xd0, 0x49, 0xe2, 0x90, 0x4e, 0xfb, 0x2c,
+	0xb9, 0x98, 0x36, 0xc4, 0x9f, 0x1d, 0x3b, 0x0e, 0xa9, 0xcd, 0xa8, 0x30, 0x67, 0x93, 0x3e, 0xb3,
+	0x5c, 0xdf, 0x80, 0xb1, 0xad, 0x23, 0xd0, 0x68, 0x0a, 0x20, 0x5b, 0xe3, 0x90, 0xf7, 0xad, 0x35,
+	0x20, 0xd6, 0x4d, 0x89, 0x33, 0x4f, 0x68, 0x70, 0xe2, 0xbe, 0xa4, 0xd3, 0x11, 0x23, 0x51, 0x20,
+	0x65, 0x9a, 0xb5, 0xa6, 0xa4, 0x59, 0xd6, 0x25, 0xe8, 0xe0, 0xba, 0xc8, 0x2a, 0x74, 0xbf, 0xb9,
+	0xf7, 0xf5, 0x81, 0x3d, 0x5e, 0xc2, 0xcf, 0x47, 0xec, 0xd3, 0xb0, 0xf6, 0x61, 0x88, 0x35, 0x7d,
+	0x10, 0xbd, 0x3c, 0xc4, 0x7e, 0x00, 0x9e, 0xed, 0xca, 0x23, 0xf7, 0xcd, 0x11, 0x0d, 0x43, 0x59,
+	0xfc, 0x9c, 0xb8, 0x6f, 0x1c, 0xac, 0x11, 0xc8, 0x36, 0x2c, 0x3f, 0x72, 0xdf, 0xec, 0xe5, 0xd2,
+	0x5b, 0xaf, 0x20, 0x66, 0x96, 0x9f, 0x5b, 0xff, 0x6c, 0x40, 0x17, 0xb9, 0x50, 0x2c, 0x38, 0x4e,
+	0xd0, 0xc0, 0x9d, 0xc5, 0x05, 0x80, 0xad, 0x52, 0x90, 0x5d, 0xe8, 0x67, 0xca, 0x84, 0x56, 0xd3,
+	0x84, 0x91, 0x42, 0x81, 0xd6, 0x52, 0x5a, 0x44, 0x5b, 0xb3, 0x88, 0xaa, 0x15, 0xb5, 0x9b, 0x13,
+	0xca, 0xae, 0x1e, 0x03, 0x76, 0x61, 0x43, 0xd3, 0xc0, 0xbb, 0x44, 0xc1, 0x7f, 0x30, 0x60, 0xb3,
+	0x32, 0x49, 0xb8, 0xb0, 0x7b, 0xb0, 0xcc, 0x1a, 0x2b, 0x32, 0xb9, 0xfb, 0x44, 0xed, 0x9c, 0xd4,
+	0xc8, 0x77, 0xf8, 0x50, 0x34, 0xa5, 0xf8, 0x44, 0xf3, 0x29, 0xf4, 0x15, 0x70, 0x83, 0x5f, 0xfd,
+	0xa9, 0xde, 0x94, 0xda, 0x6c, 0x16, 0xa1, 0xb8, 0xdb, 0x5f, 0xc1, 0xe0, 0x59, 0x34, 0xfb, 0x11,
+	0xaf, 0x80, 0xd8, 0x69, 0x4e, 0xa8, 0xa8, 0x2d, 0x84, 0x9b, 0x2d, 0x01, 0xd6, 0x1a, 0x0c, 0x05,
+	0xdf, 0xf2, 0xb9, 0xea, 0x59, 0x84, 0x5d, 0x98, 0x77, 0x7d, 0xae, 0xfa, 0xce, 0x00, 0xa2, 0xce,
+	0x28, 0x03, 0x41, 0xce, 0xa0, 0x95, 0x40, 0x20, 0x81, 0x32, 0x10, 0x14, 0x44, 0x7a, 0x20, 0x90,
+	0x60, 0x1e, 0x08, 0xc8, 0x4f, 0xa0, 0xaf, 0xf2, 0xe2, 0x7d, 0x76, 0x28, 0x39, 0x5d, 0xbf, 0x0c,
+	0xab, 0x45, 0x8d, 0x80, 0xdd, 0xc7, 0xbd, 0x67, 0x7f, 0x38, 0x5e, 0xc2, 0xee, 0xe3, 0xd1, 0xc1,
+	0xe1, 0xe1, 0xd8, 0xd8, 0xfd, 0x2f, 0x03, 0x56, 0x9e, 0xe7, 0xfe, 0xc3, 0x28, 0xc8, 0xc8, 0x01,
+	0x40, 0xf9, 0xbe, 0x46, 0x2e, 0x14, 0xf9, 0x58, 0xf5, 0x95, 0xce, 0x34, 0x9b, 0x50, 0x42, 0x4f,
+	0x4b, 0xe4, 0x01, 0xf4, 0x95, 0x08, 0x48, 0xcc, 0xc5, 0x59, 0x80, 0x79, 0xb1, 0x11, 0x57, 0x70,
+	0x3a, 0x00, 0x28, 0x35, 0x58, 0x2e, 0xa8, 0x76, 0x0e, 0xa6, 0xd9, 0x84, 0x92, 0x6c, 0x76, 0xff,
+	0x67, 0x1d, 0xda, 0xcf, 0x73, 0x9f, 0x3c, 0x87, 0xbe, 0xf2, 0xe6, 0x4f, 0x6a, 0x9d, 0xe3, 0x72,
+	0x39, 0x4d, 0xbf, 0x06, 0x98, 0xdf, 0xfe, 0xcb, 0x7f, 0x7f, 0xdf, 0xda, 0xb0, 0xd6, 0x6e, 0x9e,
+	0xfe, 0xce, 0x4d, 0xd7, 0xf7, 0xe5, 0x8d, 0xb9, 0x6b, 0x5c, 0x27, 0x36, 0xac, 0x88, 0x67, 0x7d,
+	0xb2, 0xa5, 0xf0, 0x50, 0x32, 0x35, 0x73, 0xbb, 0x06, 0x17, 0x7c, 0xb7, 0x18, 0xdf, 0xb1, 0xd5,
+	0x17, 0x7c, 0xf1, 0xf2, 0x22, 0xcf, 0x3d, 0x68, 0xef, 0xb9, 0x11, 0x21, 0xe5, 0x13, 0x8e, 0x34,
+	0x72, 0x73, 0x5d, 0x83, 0x09, 0x3e, 0x84, 0xf1, 0x19, 0x58, 0x2b, 0xc8, 0x67, 0xe6, 0x46, 0xc8,
+	0xe3, 0x05, 0x0c, 0xd4, 0xc7, 0x5b, 0x52, 0x3e, 0x37, 0xd6, 0x5f, 0x91, 0xcd, 0x4b, 0xcd, 0x48,
+	0xc1, 0xfe, 0x22, 0x63, 0xbf, 0x69, 0x8d, 0x91, 0x3d, 0x7b, 0x8e, 0x16, 0xd9, 0xb9, 0xd8, 0xbf,
+	0x78, 0xde, 0x2d, 0xf7, 0xaf, 0xbf, 0x0e, 0x9b, 0xdb, 0x35, 0x78, 0xd3, 0xfe, 0xc5, 0xf5, 0x43,
+	0x9e, 0x7f, 0x0a, 0x43, 0xed, 0xf5, 0x90, 0x14, 0xeb, 0x6b, 0x7a, 0x96, 0x34, 0x3f, 0x58, 0x80,
+	0x15, 0x52, 0x2e, 0x31, 0x29, 0x5b, 0xd6, 0x04, 0xa5, 0xf8, 0x82, 0x84, 0xbd, 0x37, 0xa2, 0xac,
+	0xe7, 0x00, 0xe5, 0x2b, 0x5c, 0x69, 0x68, 0xb5, 0x97, 0x3f, 0xd3, 0x6c, 0x42, 0x09, 0x11, 0xeb,
+	0x4c, 0xc4, 0x90, 0xf4, 0xf9, 0x01, 0x70, 0x5e, 0x87, 0xb0, 0x22, 0x5e, 0x9c, 0x4a, 0xcd, 0xe8,
+	0xcf, 0x6e, 0xe6, 0x76, 0x0d, 0x2e, 0x18, 0x8e, 0x19, 0x43, 0x20, 0x3d, 0x64, 0x18, 0x20, 0x8b,
+	0x3f, 0x86, 0xbe, 0xf2, 0x0e, 0x43, 0xd4, 0xd5, 0x54, 0x1e, 0x77, 0xcc, 0x8b, 0x8d, 0x38, 0xc1,
+	0x79, 0x83, 0x71, 0x1e, 0x91, 0x01, 0x72, 0x46, 0x2d, 0x30, 0xee, 0xbf, 0x06, 0x28, 0x1f, 0x17,
+	0x4a, 0x2d, 0xd4, 0xde, 0x3e, 0x4c, 0xb3, 0x09, 0xa5, 0x9b, 0x21, 0x01, 0x64, 0x2d, 0x7a, 0x75,
+	0x2f, 0x61, 0xa4, 0xbf, 0xe8, 0x90, 0x0f, 0x54, 0x0e, 0xb5, 0x27, 0x20, 0xf3, 0xf2, 0x22, 0xb4,
+	0x6e, 0x33, 0x64, 0xc4, 0x6c, 0xa6, 0x64, 0x7b, 0x04, 0xab, 0xc5, 0xab, 0x04, 0x99, 0xaa, 0x4c,
+	0xd4, 0xc7, 0x0b, 0xf3, 0x42, 0x03, 0x46, 0x70, 0x9e, 0x30, 0xce, 0x7d, 0xb2, 0x8a, 0x9c, 0x79,
+	0x17, 0x4b, 0x32, 0xa5, 0xb4, 0xc6, 0x94, 0xd2, 0x45, 0x4c, 0x29, 0x5d, 0xc8, 0x94, 0xf1, 0xf9,
+	0x0d, 0xd7, 0x35, 0x7f, 0x9c, 0xd0, 0x75, 0xad, 0xbd, 0x6d, 0x98, 0x66, 0x13, 0x4a, 0xf0, 0xbd,
+	0xc0, 0xf8, 0xae, 0x5b, 0x4c, 0x0d, 0x61, 0x90, 0x66, 0xfc, 0xf1, 0x02, 0x2d, 0xda, 0x81, 0xbe,
+	0xd2, 0x49, 0x2f, 0x2d, 0xa5, 0xde, 0xf4, 0x37, 0x2f, 0x36, 0xe2, 0x84, 0x88, 0x6d, 0x26, 0x62,
+	0xc2, 0xbd, 0x5e, 0x3c, 0xa7, 0x91, 0xb8, 0xf5, 0xe4, 0x4f, 0x00, 0xca, 0x3e, 0x48, 0xb9, 0x81,
+	0x5a, 0x87, 0xcc, 0xdc, 0xae, 0xa3, 0x58, 0xdb, 0x44, 0x5f, 0x3d, 0xeb, 0x4d, 0x31, 0x73, 0xb9,
+	0x6b, 0x5c, 0xbf, 0x65, 0x90, 0x17, 0x30, 0x2a, 0xe9, 0x8f, 0xce, 0x23, 0xef, 0x6d, 0x22, 0xcc,
+	0x26, 0x94, 0xd8, 0xc0, 0x07, 0x4c, 0xca, 0xb6, 0x45, 0x74, 0x29, 0xe9, 0x79, 0xe4, 0xa1, 0x9e,
+	0xfe, 0x08, 0xfa, 0xca, 0xbf, 0x06, 0xa5, 0x9e, 0xea, 0x3f, 0x20, 0x98, 0x4d, 0x7d, 0x19, 0x3d,
+	0x2a, 0x50, 0x3e, 0x09, 0x5b, 0x26, 0xc8, 0x3b, 0x82, 0x91, 0xde, 0x50, 0x28, 0xcd, 0xbe, 0xb1,
+	0x3b, 0x61, 0x5e, 0x5e, 0x84, 0x6e, 0xda, 0x0b, 0xeb, 0x16, 0x53, 0x35, 0x0a, 0xcd, 0x30, 0xf0,
+	0x16, 0x8d, 0x05, 0x35, 0xf0, 0x56, 0x7b, 0x17, 0xe6, 0xc5, 0x46, 0x5c, 0x53, 0xa4, 0xe3, 0x62,
+	0xe4, 0xc9, 0xa0, 0xdd, 0x96, 0x6d, 0x85, 0xf2, 0x4c, 0x6a, 0x9d, 0x09, 0xd3, 0x6c, 0x42, 0x35,
+	0xd9, 0x2d, 0x17, 0x20, 0xa3, 0xde, 0xaf, 0xa0, 0x27, 0xcb, 0x58, 0x52, 0x58, 0x4e, 0xa5, 0xd6,
+	0x35, 0xa7, 0x75, 0x44, 0xc5, 0x5c, 0x99, 0x63, 0x4b, 0x05, 0x16, 0xf9, 0x52, 0x58, 0xab, 0x94,
+	0xc2, 0xa4, 0xd0, 0x76, 0x73, 0x8d, 0x6c, 0xea, 0x3f, 0x17, 0xf0, 0x07, 0x00, 0x19, 0x06, 0xc9,
+	0x3a, 0x13, 0x20, 0x27, 0x72, 0x93, 0xba, 0x65, 0x90, 0x19, 0x8c, 0xf4, 0xda, 0xb9, 0x3c, 0xf2,
+	0xc6, 0x9a, 0xfa, 0xad, 0x46, 0x45, 0x88, 0x26, 0x04, 0xcd, 0x0a, 0x65, 0xcc, 0x2b, 0xf5, 0xb9,
+	0x28, 0xb4, 0xdf, 0x4f, 0x94, 0x98, 0x64, 0x7d, 0xc8, 0x44, 0x5d, 0x24, 0x17, 0x6a, 0xa2, 0x64,
+	0xcb, 0xf3, 0x96, 0x41, 0x5e, 0x56, 0x2b, 0xa9, 0x4b, 0x0b, 0x52, 0xff, 0x4a, 0x28, 0x6e, 0x2c,
+	0x0c, 0xe4, 0xe9, 0x13, 0x16, 0x8a, 0x33, 0x4e, 0xc2, 0xeb, 0x03, 0xf2, 0x4b, 0xe8, 0xb2, 0xac,
+	0x9b, 0x6c, 0x94, 0x09, 0x5d, 0x99, 0xdc, 0x9b, 0x9b, 0x15, 0xa8, 0x1e, 0xcd, 0x2c, 0xe6, 0x5e,
+	0xf3, 0x88, 0xe7, 0x3e, 0xb3, 0x65, 0xf6, 0xa3, 0xe7, 0x67, 0xff, 0x3b, 0x00, 0x83, 0x67, 0xd0,
+	0x32, 0x13, 0x2a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4252,6 +4346,9 @@ type XudClient interface {
 	// to a specific node.
 	// shell: xucli ban <node_key>
 	Ban(ctx context.Context, in *BanRequest, opts ...grpc.CallOption) (*BanResponse, error)
+	// Closes any existing payment channels with a peer for the specified currency.
+	// shell: xucli closechannel <currency> [node_identifier] [--force]
+	CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (*CloseChannelResponse, error)
 	// Attempts to connect to a node. Once connected, the node is added to the list of peers and
 	// becomes available for swaps and trading. A handshake exchanges information about the peer's
 	// supported trading and swap clients. Orders will be shared with the peer upon connection and
@@ -4378,6 +4475,15 @@ func (c *xudClient) Ban(ctx context.Context, in *BanRequest, opts ...grpc.CallOp
 	return out, nil
 }
 
+func (c *xudClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (*CloseChannelResponse, error) {
+	out := new(CloseChannelResponse)
+	err := c.cc.Invoke(ctx, "/xudrpc.Xud/CloseChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xudClient) Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*ConnectResponse, error) {
 	out := new(ConnectResponse)
 	err := c.cc.Invoke(ctx, "/xudrpc.Xud/Connect", in, out, opts...)
@@ -4691,6 +4797,9 @@ type XudServer interface {
 	// to a specific node.
 	// shell: xucli ban <node_key>
 	Ban(context.Context, *BanRequest) (*BanResponse, error)
+	// Closes any existing payment channels with a peer for the specified currency.
+	// shell: xucli closechannel <currency> [node_identifier] [--force]
+	CloseChannel(context.Context, *CloseChannelRequest) (*CloseChannelResponse, error)
 	// Attempts to connect to a node. Once connected, the node is added to the list of peers and
 	// becomes available for swaps and trading. A handshake exchanges information about the peer's
 	// supported trading and swap clients. Orders will be shared with the peer upon connection and
@@ -4840,6 +4949,24 @@ func _Xud_Ban_Handler(srv interface{}, ctx context.Context, dec func(interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xud_CloseChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XudServer).CloseChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xudrpc.Xud/CloseChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XudServer).CloseChannel(ctx, req.(*CloseChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xud_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConnectRequest)
 	if err := dec(in); err != nil {
@@ -5282,6 +5409,10 @@ var _Xud_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Ban",
 			Handler:    _Xud_Ban_Handler,
 		},
+		{
+			MethodName: "CloseChannel",
+			Handler:    _Xud_CloseChannel_Handler,
+		},
 		{
 			MethodName: "Connect",
 			Handler:    _Xud_Connect_Handler,